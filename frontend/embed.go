@@ -0,0 +1,23 @@
+//go:build embedfrontend
+
+// Package frontend holds the built web frontend. It is only embedded when
+// building with the embedfrontend tag, after building the frontend into
+// dist.
+package frontend
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed all:dist
+var dist embed.FS
+
+// Dist returns the built frontend, and false if it was not embedded.
+func Dist() (fs.FS, bool) {
+	sub, err := fs.Sub(dist, "dist")
+	if err != nil {
+		panic(err)
+	}
+	return sub, true
+}
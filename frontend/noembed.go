@@ -0,0 +1,10 @@
+//go:build !embedfrontend
+
+package frontend
+
+import "io/fs"
+
+// Dist returns the built frontend, and false if it was not embedded.
+func Dist() (fs.FS, bool) {
+	return nil, false
+}
@@ -8,7 +8,7 @@
 // versions:
 // 	protoc-gen-grpc-web v1.5.0
 // 	protoc              v5.26.1
-// source: asset.proto
+// source: proto/asset.proto
 
 
 /* eslint-disable */
@@ -19,6 +19,12 @@
 const grpc = {};
 grpc.web = require('grpc-web');
 
+
+var google_api_annotations_pb = require('../google/api/annotations_pb.js')
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
+
+var proto_validate_pb = require('../proto/validate_pb.js')
 const proto = {};
 proto.assets = require('./asset_pb.js');
 
@@ -321,16 +327,16 @@ proto.assets.AssetServicePromiseClient.prototype.deleteAsset =
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.assets.Empty,
+ *   !proto.assets.ListAssetsRequest,
  *   !proto.assets.AssetList>}
  */
 const methodDescriptor_AssetService_ListAssets = new grpc.web.MethodDescriptor(
   '/assets.AssetService/ListAssets',
   grpc.web.MethodType.UNARY,
-  proto.assets.Empty,
+  proto.assets.ListAssetsRequest,
   proto.assets.AssetList,
   /**
-   * @param {!proto.assets.Empty} request
+   * @param {!proto.assets.ListAssetsRequest} request
    * @return {!Uint8Array}
    */
   function(request) {
@@ -341,7 +347,7 @@ const methodDescriptor_AssetService_ListAssets = new grpc.web.MethodDescriptor(
 
 
 /**
- * @param {!proto.assets.Empty} request The
+ * @param {!proto.assets.ListAssetsRequest} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
@@ -362,7 +368,7 @@ proto.assets.AssetServiceClient.prototype.listAssets =
 
 
 /**
- * @param {!proto.assets.Empty} request The
+ * @param {!proto.assets.ListAssetsRequest} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
@@ -379,5 +385,493 @@ proto.assets.AssetServicePromiseClient.prototype.listAssets =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.assets.BatchCreateAssetsRequest,
+ *   !proto.assets.BatchAssetsResponse>}
+ */
+const methodDescriptor_AssetService_BatchCreateAssets = new grpc.web.MethodDescriptor(
+  '/assets.AssetService/BatchCreateAssets',
+  grpc.web.MethodType.UNARY,
+  proto.assets.BatchCreateAssetsRequest,
+  proto.assets.BatchAssetsResponse,
+  /**
+   * @param {!proto.assets.BatchCreateAssetsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.assets.BatchAssetsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.assets.BatchCreateAssetsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.assets.BatchAssetsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.assets.BatchAssetsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.assets.AssetServiceClient.prototype.batchCreateAssets =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/assets.AssetService/BatchCreateAssets',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_BatchCreateAssets,
+      callback);
+};
+
+
+/**
+ * @param {!proto.assets.BatchCreateAssetsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.assets.BatchAssetsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.assets.AssetServicePromiseClient.prototype.batchCreateAssets =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/assets.AssetService/BatchCreateAssets',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_BatchCreateAssets);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.assets.BatchUpdateAssetsRequest,
+ *   !proto.assets.BatchAssetsResponse>}
+ */
+const methodDescriptor_AssetService_BatchUpdateAssets = new grpc.web.MethodDescriptor(
+  '/assets.AssetService/BatchUpdateAssets',
+  grpc.web.MethodType.UNARY,
+  proto.assets.BatchUpdateAssetsRequest,
+  proto.assets.BatchAssetsResponse,
+  /**
+   * @param {!proto.assets.BatchUpdateAssetsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.assets.BatchAssetsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.assets.BatchUpdateAssetsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.assets.BatchAssetsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.assets.BatchAssetsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.assets.AssetServiceClient.prototype.batchUpdateAssets =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/assets.AssetService/BatchUpdateAssets',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_BatchUpdateAssets,
+      callback);
+};
+
+
+/**
+ * @param {!proto.assets.BatchUpdateAssetsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.assets.BatchAssetsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.assets.AssetServicePromiseClient.prototype.batchUpdateAssets =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/assets.AssetService/BatchUpdateAssets',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_BatchUpdateAssets);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.assets.BatchDeleteAssetsRequest,
+ *   !proto.assets.BatchAssetsResponse>}
+ */
+const methodDescriptor_AssetService_BatchDeleteAssets = new grpc.web.MethodDescriptor(
+  '/assets.AssetService/BatchDeleteAssets',
+  grpc.web.MethodType.UNARY,
+  proto.assets.BatchDeleteAssetsRequest,
+  proto.assets.BatchAssetsResponse,
+  /**
+   * @param {!proto.assets.BatchDeleteAssetsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.assets.BatchAssetsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.assets.BatchDeleteAssetsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.assets.BatchAssetsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.assets.BatchAssetsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.assets.AssetServiceClient.prototype.batchDeleteAssets =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/assets.AssetService/BatchDeleteAssets',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_BatchDeleteAssets,
+      callback);
+};
+
+
+/**
+ * @param {!proto.assets.BatchDeleteAssetsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.assets.BatchAssetsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.assets.AssetServicePromiseClient.prototype.batchDeleteAssets =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/assets.AssetService/BatchDeleteAssets',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_BatchDeleteAssets);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.assets.UpsertAssetRequest,
+ *   !proto.assets.Asset>}
+ */
+const methodDescriptor_AssetService_UpsertAsset = new grpc.web.MethodDescriptor(
+  '/assets.AssetService/UpsertAsset',
+  grpc.web.MethodType.UNARY,
+  proto.assets.UpsertAssetRequest,
+  proto.assets.Asset,
+  /**
+   * @param {!proto.assets.UpsertAssetRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.assets.Asset.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.assets.UpsertAssetRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.assets.Asset)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.assets.Asset>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.assets.AssetServiceClient.prototype.upsertAsset =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/assets.AssetService/UpsertAsset',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_UpsertAsset,
+      callback);
+};
+
+
+/**
+ * @param {!proto.assets.UpsertAssetRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.assets.Asset>}
+ *     Promise that resolves to the response
+ */
+proto.assets.AssetServicePromiseClient.prototype.upsertAsset =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/assets.AssetService/UpsertAsset',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_UpsertAsset);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.assets.Empty,
+ *   !proto.assets.AssetList>}
+ */
+const methodDescriptor_AssetService_ListDeletedAssets = new grpc.web.MethodDescriptor(
+  '/assets.AssetService/ListDeletedAssets',
+  grpc.web.MethodType.UNARY,
+  proto.assets.Empty,
+  proto.assets.AssetList,
+  /**
+   * @param {!proto.assets.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.assets.AssetList.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.assets.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.assets.AssetList)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.assets.AssetList>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.assets.AssetServiceClient.prototype.listDeletedAssets =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/assets.AssetService/ListDeletedAssets',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_ListDeletedAssets,
+      callback);
+};
+
+
+/**
+ * @param {!proto.assets.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.assets.AssetList>}
+ *     Promise that resolves to the response
+ */
+proto.assets.AssetServicePromiseClient.prototype.listDeletedAssets =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/assets.AssetService/ListDeletedAssets',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_ListDeletedAssets);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.assets.RestoreAssetRequest,
+ *   !proto.assets.Asset>}
+ */
+const methodDescriptor_AssetService_RestoreAsset = new grpc.web.MethodDescriptor(
+  '/assets.AssetService/RestoreAsset',
+  grpc.web.MethodType.UNARY,
+  proto.assets.RestoreAssetRequest,
+  proto.assets.Asset,
+  /**
+   * @param {!proto.assets.RestoreAssetRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.assets.Asset.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.assets.RestoreAssetRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.assets.Asset)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.assets.Asset>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.assets.AssetServiceClient.prototype.restoreAsset =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/assets.AssetService/RestoreAsset',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_RestoreAsset,
+      callback);
+};
+
+
+/**
+ * @param {!proto.assets.RestoreAssetRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.assets.Asset>}
+ *     Promise that resolves to the response
+ */
+proto.assets.AssetServicePromiseClient.prototype.restoreAsset =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/assets.AssetService/RestoreAsset',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_RestoreAsset);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.assets.PurgeAssetRequest,
+ *   !proto.assets.Empty>}
+ */
+const methodDescriptor_AssetService_PurgeAsset = new grpc.web.MethodDescriptor(
+  '/assets.AssetService/PurgeAsset',
+  grpc.web.MethodType.UNARY,
+  proto.assets.PurgeAssetRequest,
+  proto.assets.Empty,
+  /**
+   * @param {!proto.assets.PurgeAssetRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.assets.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.assets.PurgeAssetRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.assets.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.assets.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.assets.AssetServiceClient.prototype.purgeAsset =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/assets.AssetService/PurgeAsset',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_PurgeAsset,
+      callback);
+};
+
+
+/**
+ * @param {!proto.assets.PurgeAssetRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.assets.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.assets.AssetServicePromiseClient.prototype.purgeAsset =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/assets.AssetService/PurgeAsset',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_PurgeAsset);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.assets.ListAuditEventsRequest,
+ *   !proto.assets.ListAuditEventsResponse>}
+ */
+const methodDescriptor_AssetService_ListAuditEvents = new grpc.web.MethodDescriptor(
+  '/assets.AssetService/ListAuditEvents',
+  grpc.web.MethodType.UNARY,
+  proto.assets.ListAuditEventsRequest,
+  proto.assets.ListAuditEventsResponse,
+  /**
+   * @param {!proto.assets.ListAuditEventsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.assets.ListAuditEventsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.assets.ListAuditEventsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.assets.ListAuditEventsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.assets.ListAuditEventsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.assets.AssetServiceClient.prototype.listAuditEvents =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/assets.AssetService/ListAuditEvents',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_ListAuditEvents,
+      callback);
+};
+
+
+/**
+ * @param {!proto.assets.ListAuditEventsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.assets.ListAuditEventsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.assets.AssetServicePromiseClient.prototype.listAuditEvents =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/assets.AssetService/ListAuditEvents',
+      request,
+      metadata || {},
+      methodDescriptor_AssetService_ListAuditEvents);
+};
+
+
 module.exports = proto.assets;
 
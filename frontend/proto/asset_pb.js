@@ -1,4 +1,4 @@
-// source: asset.proto
+// source: proto/asset.proto
 /**
  * @fileoverview
  * @enhanceable
@@ -21,13 +21,31 @@ var global =
     (function () { return this; }).call(null) ||
     Function('return this')();
 
+var google_api_annotations_pb = require('../google/api/annotations_pb.js');
+goog.object.extend(proto, google_api_annotations_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
+var proto_validate_pb = require('../proto/validate_pb.js');
+goog.object.extend(proto, proto_validate_pb);
 goog.exportSymbol('proto.assets.Asset', null, global);
 goog.exportSymbol('proto.assets.AssetList', null, global);
+goog.exportSymbol('proto.assets.AuditEvent', null, global);
+goog.exportSymbol('proto.assets.BatchAssetsResponse', null, global);
+goog.exportSymbol('proto.assets.BatchCreateAssetsRequest', null, global);
+goog.exportSymbol('proto.assets.BatchDeleteAssetsRequest', null, global);
+goog.exportSymbol('proto.assets.BatchItemResult', null, global);
+goog.exportSymbol('proto.assets.BatchUpdateAssetsRequest', null, global);
 goog.exportSymbol('proto.assets.CreateAssetRequest', null, global);
 goog.exportSymbol('proto.assets.DeleteAssetRequest', null, global);
 goog.exportSymbol('proto.assets.Empty', null, global);
 goog.exportSymbol('proto.assets.GetAssetRequest', null, global);
+goog.exportSymbol('proto.assets.ListAssetsRequest', null, global);
+goog.exportSymbol('proto.assets.ListAuditEventsRequest', null, global);
+goog.exportSymbol('proto.assets.ListAuditEventsResponse', null, global);
+goog.exportSymbol('proto.assets.PurgeAssetRequest', null, global);
+goog.exportSymbol('proto.assets.RestoreAssetRequest', null, global);
 goog.exportSymbol('proto.assets.UpdateAssetRequest', null, global);
+goog.exportSymbol('proto.assets.UpsertAssetRequest', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -91,6 +109,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.assets.GetAssetRequest.displayName = 'proto.assets.GetAssetRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.ListAssetsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.assets.ListAssetsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.ListAssetsRequest.displayName = 'proto.assets.ListAssetsRequest';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -112,6 +151,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.assets.UpdateAssetRequest.displayName = 'proto.assets.UpdateAssetRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.UpsertAssetRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.assets.UpsertAssetRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.UpsertAssetRequest.displayName = 'proto.assets.UpsertAssetRequest';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -133,6 +193,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.assets.DeleteAssetRequest.displayName = 'proto.assets.DeleteAssetRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.RestoreAssetRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.assets.RestoreAssetRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.RestoreAssetRequest.displayName = 'proto.assets.RestoreAssetRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.PurgeAssetRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.assets.PurgeAssetRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.PurgeAssetRequest.displayName = 'proto.assets.PurgeAssetRequest';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -175,6 +277,174 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.assets.AssetList.displayName = 'proto.assets.AssetList';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.BatchCreateAssetsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.assets.BatchCreateAssetsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.assets.BatchCreateAssetsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.BatchCreateAssetsRequest.displayName = 'proto.assets.BatchCreateAssetsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.BatchUpdateAssetsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.assets.BatchUpdateAssetsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.assets.BatchUpdateAssetsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.BatchUpdateAssetsRequest.displayName = 'proto.assets.BatchUpdateAssetsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.BatchDeleteAssetsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.assets.BatchDeleteAssetsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.assets.BatchDeleteAssetsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.BatchDeleteAssetsRequest.displayName = 'proto.assets.BatchDeleteAssetsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.BatchItemResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.assets.BatchItemResult, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.BatchItemResult.displayName = 'proto.assets.BatchItemResult';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.BatchAssetsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.assets.BatchAssetsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.assets.BatchAssetsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.BatchAssetsResponse.displayName = 'proto.assets.BatchAssetsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.AuditEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.assets.AuditEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.AuditEvent.displayName = 'proto.assets.AuditEvent';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.ListAuditEventsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.assets.ListAuditEventsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.ListAuditEventsRequest.displayName = 'proto.assets.ListAuditEventsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.ListAuditEventsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.assets.ListAuditEventsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.assets.ListAuditEventsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.ListAuditEventsResponse.displayName = 'proto.assets.ListAuditEventsResponse';
+}
 
 
 
@@ -210,7 +480,14 @@ proto.assets.Asset.toObject = function(includeInstance, msg) {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
     symbol: jspb.Message.getFieldWithDefault(msg, 2, ""),
     quantity: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    price: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0)
+    price: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    version: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    deleteTime: (f = msg.getDeleteTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    createTime: (f = msg.getCreateTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    updateTime: (f = msg.getUpdateTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    createdBy: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    updatedBy: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    owner: jspb.Message.getFieldWithDefault(msg, 11, "")
   };
 
   if (includeInstance) {
@@ -263,6 +540,37 @@ proto.assets.Asset.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {number} */ (reader.readDouble());
       msg.setPrice(value);
       break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setVersion(value);
+      break;
+    case 6:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setDeleteTime(value);
+      break;
+    case 7:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreateTime(value);
+      break;
+    case 8:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setUpdateTime(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCreatedBy(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUpdatedBy(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -320,6 +628,58 @@ proto.assets.Asset.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getVersion();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getDeleteTime();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getCreateTime();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getUpdateTime();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getCreatedBy();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getUpdatedBy();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
 };
 
 
@@ -395,12 +755,195 @@ proto.assets.Asset.prototype.setPrice = function(value) {
 };
 
 
+/**
+ * optional int64 version = 5;
+ * @return {number}
+ */
+proto.assets.Asset.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
+ * @param {number} value
+ * @return {!proto.assets.Asset} returns this
+ */
+proto.assets.Asset.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp delete_time = 6;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.assets.Asset.prototype.getDeleteTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 6));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.assets.Asset} returns this
+*/
+proto.assets.Asset.prototype.setDeleteTime = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.assets.Asset} returns this
+ */
+proto.assets.Asset.prototype.clearDeleteTime = function() {
+  return this.setDeleteTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.assets.Asset.prototype.hasDeleteTime = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp create_time = 7;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.assets.Asset.prototype.getCreateTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 7));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.assets.Asset} returns this
+*/
+proto.assets.Asset.prototype.setCreateTime = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.assets.Asset} returns this
+ */
+proto.assets.Asset.prototype.clearCreateTime = function() {
+  return this.setCreateTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.assets.Asset.prototype.hasCreateTime = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp update_time = 8;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.assets.Asset.prototype.getUpdateTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 8));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.assets.Asset} returns this
+*/
+proto.assets.Asset.prototype.setUpdateTime = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.assets.Asset} returns this
+ */
+proto.assets.Asset.prototype.clearUpdateTime = function() {
+  return this.setUpdateTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.assets.Asset.prototype.hasUpdateTime = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional string created_by = 9;
+ * @return {string}
+ */
+proto.assets.Asset.prototype.getCreatedBy = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.Asset} returns this
+ */
+proto.assets.Asset.prototype.setCreatedBy = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional string updated_by = 10;
+ * @return {string}
+ */
+proto.assets.Asset.prototype.getUpdatedBy = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.Asset} returns this
+ */
+proto.assets.Asset.prototype.setUpdatedBy = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
+/**
+ * optional string owner = 11;
+ * @return {string}
+ */
+proto.assets.Asset.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.Asset} returns this
+ */
+proto.assets.Asset.prototype.setOwner = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
  * Field names that are reserved in JavaScript and will be renamed to pb_name.
  * Optional fields that are not set will be set to undefined.
  * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
@@ -429,7 +972,8 @@ proto.assets.CreateAssetRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     symbol: jspb.Message.getFieldWithDefault(msg, 1, ""),
     quantity: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    price: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0)
+    price: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    owner: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -478,6 +1022,10 @@ proto.assets.CreateAssetRequest.deserializeBinaryFromReader = function(msg, read
       var value = /** @type {number} */ (reader.readDouble());
       msg.setPrice(value);
       break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -528,6 +1076,13 @@ proto.assets.CreateAssetRequest.serializeBinaryToWriter = function(message, writ
       f
     );
   }
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -585,6 +1140,24 @@ proto.assets.CreateAssetRequest.prototype.setPrice = function(value) {
 };
 
 
+/**
+ * optional string owner = 4;
+ * @return {string}
+ */
+proto.assets.CreateAssetRequest.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.CreateAssetRequest} returns this
+ */
+proto.assets.CreateAssetRequest.prototype.setOwner = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
 
 
 
@@ -617,7 +1190,8 @@ proto.assets.GetAssetRequest.prototype.toObject = function(opt_includeInstance)
  */
 proto.assets.GetAssetRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    asOf: (f = msg.getAsOf()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -658,6 +1232,11 @@ proto.assets.GetAssetRequest.deserializeBinaryFromReader = function(msg, reader)
       var value = /** @type {string} */ (reader.readString());
       msg.setId(value);
       break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setAsOf(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -694,6 +1273,14 @@ proto.assets.GetAssetRequest.serializeBinaryToWriter = function(message, writer)
       f
     );
   }
+  f = message.getAsOf();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -715,6 +1302,43 @@ proto.assets.GetAssetRequest.prototype.setId = function(value) {
 };
 
 
+/**
+ * optional google.protobuf.Timestamp as_of = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.assets.GetAssetRequest.prototype.getAsOf = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.assets.GetAssetRequest} returns this
+*/
+proto.assets.GetAssetRequest.prototype.setAsOf = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.assets.GetAssetRequest} returns this
+ */
+proto.assets.GetAssetRequest.prototype.clearAsOf = function() {
+  return this.setAsOf(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.assets.GetAssetRequest.prototype.hasAsOf = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
 
 
 
@@ -731,8 +1355,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.assets.UpdateAssetRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.assets.UpdateAssetRequest.toObject(opt_includeInstance, this);
+proto.assets.ListAssetsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.ListAssetsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -741,16 +1365,14 @@ proto.assets.UpdateAssetRequest.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.assets.UpdateAssetRequest} msg The msg instance to transform.
+ * @param {!proto.assets.ListAssetsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.assets.UpdateAssetRequest.toObject = function(includeInstance, msg) {
+proto.assets.ListAssetsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    symbol: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    quantity: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    price: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0)
+    asOf: (f = msg.getAsOf()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    orderBy: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -764,23 +1386,23 @@ proto.assets.UpdateAssetRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.assets.UpdateAssetRequest}
+ * @return {!proto.assets.ListAssetsRequest}
  */
-proto.assets.UpdateAssetRequest.deserializeBinary = function(bytes) {
+proto.assets.ListAssetsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.assets.UpdateAssetRequest;
-  return proto.assets.UpdateAssetRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.assets.ListAssetsRequest;
+  return proto.assets.ListAssetsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.assets.UpdateAssetRequest} msg The message object to deserialize into.
+ * @param {!proto.assets.ListAssetsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.assets.UpdateAssetRequest}
+ * @return {!proto.assets.ListAssetsRequest}
  */
-proto.assets.UpdateAssetRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.assets.ListAssetsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -788,20 +1410,13 @@ proto.assets.UpdateAssetRequest.deserializeBinaryFromReader = function(msg, read
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setAsOf(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSymbol(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setQuantity(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setPrice(value);
+      msg.setOrderBy(value);
       break;
     default:
       reader.skipField();
@@ -816,9 +1431,9 @@ proto.assets.UpdateAssetRequest.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.assets.UpdateAssetRequest.prototype.serializeBinary = function() {
+proto.assets.ListAssetsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.assets.UpdateAssetRequest.serializeBinaryToWriter(this, writer);
+  proto.assets.ListAssetsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -826,112 +1441,2727 @@ proto.assets.UpdateAssetRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.assets.UpdateAssetRequest} message
+ * @param {!proto.assets.ListAssetsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.assets.UpdateAssetRequest.serializeBinaryToWriter = function(message, writer) {
+proto.assets.ListAssetsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getAsOf();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getSymbol();
+  f = message.getOrderBy();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getQuantity();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
-      f
-    );
-  }
-  f = message.getPrice();
-  if (f !== 0.0) {
-    writer.writeDouble(
-      4,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional google.protobuf.Timestamp as_of = 1;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.assets.ListAssetsRequest.prototype.getAsOf = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.assets.ListAssetsRequest} returns this
+*/
+proto.assets.ListAssetsRequest.prototype.setAsOf = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.assets.ListAssetsRequest} returns this
+ */
+proto.assets.ListAssetsRequest.prototype.clearAsOf = function() {
+  return this.setAsOf(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.assets.ListAssetsRequest.prototype.hasAsOf = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string order_by = 2;
  * @return {string}
  */
-proto.assets.UpdateAssetRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.assets.ListAssetsRequest.prototype.getOrderBy = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.ListAssetsRequest} returns this
+ */
+proto.assets.ListAssetsRequest.prototype.setOrderBy = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.UpdateAssetRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.UpdateAssetRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.UpdateAssetRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.UpdateAssetRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    symbol: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    quantity: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    price: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    version: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.UpdateAssetRequest}
+ */
+proto.assets.UpdateAssetRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.UpdateAssetRequest;
+  return proto.assets.UpdateAssetRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.UpdateAssetRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.UpdateAssetRequest}
+ */
+proto.assets.UpdateAssetRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSymbol(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setQuantity(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setPrice(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setVersion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.UpdateAssetRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.UpdateAssetRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.UpdateAssetRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.UpdateAssetRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSymbol();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getQuantity();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getPrice();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+  f = message.getVersion();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.assets.UpdateAssetRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.UpdateAssetRequest} returns this
+ */
+proto.assets.UpdateAssetRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string symbol = 2;
+ * @return {string}
+ */
+proto.assets.UpdateAssetRequest.prototype.getSymbol = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.UpdateAssetRequest} returns this
+ */
+proto.assets.UpdateAssetRequest.prototype.setSymbol = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 quantity = 3;
+ * @return {number}
+ */
+proto.assets.UpdateAssetRequest.prototype.getQuantity = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.UpdateAssetRequest} returns this
+ */
+proto.assets.UpdateAssetRequest.prototype.setQuantity = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional double price = 4;
+ * @return {number}
+ */
+proto.assets.UpdateAssetRequest.prototype.getPrice = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.UpdateAssetRequest} returns this
+ */
+proto.assets.UpdateAssetRequest.prototype.setPrice = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
+};
+
+
+/**
+ * optional int64 version = 5;
+ * @return {number}
+ */
+proto.assets.UpdateAssetRequest.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.UpdateAssetRequest} returns this
+ */
+proto.assets.UpdateAssetRequest.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.UpsertAssetRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.UpsertAssetRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.UpsertAssetRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.UpsertAssetRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    symbol: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    quantity: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    price: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    owner: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.UpsertAssetRequest}
+ */
+proto.assets.UpsertAssetRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.UpsertAssetRequest;
+  return proto.assets.UpsertAssetRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.UpsertAssetRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.UpsertAssetRequest}
+ */
+proto.assets.UpsertAssetRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSymbol(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setQuantity(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setPrice(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.UpsertAssetRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.UpsertAssetRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.UpsertAssetRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.UpsertAssetRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSymbol();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getQuantity();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getPrice();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string symbol = 1;
+ * @return {string}
+ */
+proto.assets.UpsertAssetRequest.prototype.getSymbol = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.UpsertAssetRequest} returns this
+ */
+proto.assets.UpsertAssetRequest.prototype.setSymbol = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 quantity = 2;
+ * @return {number}
+ */
+proto.assets.UpsertAssetRequest.prototype.getQuantity = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.UpsertAssetRequest} returns this
+ */
+proto.assets.UpsertAssetRequest.prototype.setQuantity = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional double price = 3;
+ * @return {number}
+ */
+proto.assets.UpsertAssetRequest.prototype.getPrice = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.UpsertAssetRequest} returns this
+ */
+proto.assets.UpsertAssetRequest.prototype.setPrice = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional string owner = 4;
+ * @return {string}
+ */
+proto.assets.UpsertAssetRequest.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.UpsertAssetRequest} returns this
+ */
+proto.assets.UpsertAssetRequest.prototype.setOwner = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.DeleteAssetRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.DeleteAssetRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.DeleteAssetRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.DeleteAssetRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    version: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.DeleteAssetRequest}
+ */
+proto.assets.DeleteAssetRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.DeleteAssetRequest;
+  return proto.assets.DeleteAssetRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.DeleteAssetRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.DeleteAssetRequest}
+ */
+proto.assets.DeleteAssetRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setVersion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.DeleteAssetRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.DeleteAssetRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.DeleteAssetRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.DeleteAssetRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getVersion();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.assets.DeleteAssetRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.DeleteAssetRequest} returns this
+ */
+proto.assets.DeleteAssetRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 version = 2;
+ * @return {number}
+ */
+proto.assets.DeleteAssetRequest.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.DeleteAssetRequest} returns this
+ */
+proto.assets.DeleteAssetRequest.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.RestoreAssetRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.RestoreAssetRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.RestoreAssetRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.RestoreAssetRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    version: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.RestoreAssetRequest}
+ */
+proto.assets.RestoreAssetRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.RestoreAssetRequest;
+  return proto.assets.RestoreAssetRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.RestoreAssetRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.RestoreAssetRequest}
+ */
+proto.assets.RestoreAssetRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setVersion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.RestoreAssetRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.RestoreAssetRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.RestoreAssetRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.RestoreAssetRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getVersion();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.assets.RestoreAssetRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.RestoreAssetRequest} returns this
+ */
+proto.assets.RestoreAssetRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 version = 2;
+ * @return {number}
+ */
+proto.assets.RestoreAssetRequest.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.RestoreAssetRequest} returns this
+ */
+proto.assets.RestoreAssetRequest.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.PurgeAssetRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.PurgeAssetRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.PurgeAssetRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.PurgeAssetRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    version: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.PurgeAssetRequest}
+ */
+proto.assets.PurgeAssetRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.PurgeAssetRequest;
+  return proto.assets.PurgeAssetRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.PurgeAssetRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.PurgeAssetRequest}
+ */
+proto.assets.PurgeAssetRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setVersion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.PurgeAssetRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.PurgeAssetRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.PurgeAssetRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.PurgeAssetRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getVersion();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.assets.PurgeAssetRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.PurgeAssetRequest} returns this
+ */
+proto.assets.PurgeAssetRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 version = 2;
+ * @return {number}
+ */
+proto.assets.PurgeAssetRequest.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.PurgeAssetRequest} returns this
+ */
+proto.assets.PurgeAssetRequest.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.Empty.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.Empty.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.Empty} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.Empty.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.Empty}
+ */
+proto.assets.Empty.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.Empty;
+  return proto.assets.Empty.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.Empty} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.Empty}
+ */
+proto.assets.Empty.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.Empty.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.Empty.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.Empty} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.Empty.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.assets.AssetList.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.AssetList.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.AssetList.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.AssetList} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.AssetList.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    assetsList: jspb.Message.toObjectList(msg.getAssetsList(),
+    proto.assets.Asset.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.AssetList}
+ */
+proto.assets.AssetList.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.AssetList;
+  return proto.assets.AssetList.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.AssetList} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.AssetList}
+ */
+proto.assets.AssetList.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.assets.Asset;
+      reader.readMessage(value,proto.assets.Asset.deserializeBinaryFromReader);
+      msg.addAssets(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.AssetList.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.AssetList.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.AssetList} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.AssetList.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAssetsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.assets.Asset.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Asset assets = 1;
+ * @return {!Array<!proto.assets.Asset>}
+ */
+proto.assets.AssetList.prototype.getAssetsList = function() {
+  return /** @type{!Array<!proto.assets.Asset>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.assets.Asset, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.assets.Asset>} value
+ * @return {!proto.assets.AssetList} returns this
+*/
+proto.assets.AssetList.prototype.setAssetsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.assets.Asset=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.assets.Asset}
+ */
+proto.assets.AssetList.prototype.addAssets = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.assets.Asset, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.assets.AssetList} returns this
+ */
+proto.assets.AssetList.prototype.clearAssetsList = function() {
+  return this.setAssetsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.assets.BatchCreateAssetsRequest.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.BatchCreateAssetsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.BatchCreateAssetsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.BatchCreateAssetsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.BatchCreateAssetsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    requestsList: jspb.Message.toObjectList(msg.getRequestsList(),
+    proto.assets.CreateAssetRequest.toObject, includeInstance),
+    atomic: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.BatchCreateAssetsRequest}
+ */
+proto.assets.BatchCreateAssetsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.BatchCreateAssetsRequest;
+  return proto.assets.BatchCreateAssetsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.BatchCreateAssetsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.BatchCreateAssetsRequest}
+ */
+proto.assets.BatchCreateAssetsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.assets.CreateAssetRequest;
+      reader.readMessage(value,proto.assets.CreateAssetRequest.deserializeBinaryFromReader);
+      msg.addRequests(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAtomic(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.BatchCreateAssetsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.BatchCreateAssetsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.BatchCreateAssetsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.BatchCreateAssetsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRequestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.assets.CreateAssetRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getAtomic();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated CreateAssetRequest requests = 1;
+ * @return {!Array<!proto.assets.CreateAssetRequest>}
+ */
+proto.assets.BatchCreateAssetsRequest.prototype.getRequestsList = function() {
+  return /** @type{!Array<!proto.assets.CreateAssetRequest>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.assets.CreateAssetRequest, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.assets.CreateAssetRequest>} value
+ * @return {!proto.assets.BatchCreateAssetsRequest} returns this
+*/
+proto.assets.BatchCreateAssetsRequest.prototype.setRequestsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.assets.CreateAssetRequest=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.assets.CreateAssetRequest}
+ */
+proto.assets.BatchCreateAssetsRequest.prototype.addRequests = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.assets.CreateAssetRequest, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.assets.BatchCreateAssetsRequest} returns this
+ */
+proto.assets.BatchCreateAssetsRequest.prototype.clearRequestsList = function() {
+  return this.setRequestsList([]);
+};
+
+
+/**
+ * optional bool atomic = 2;
+ * @return {boolean}
+ */
+proto.assets.BatchCreateAssetsRequest.prototype.getAtomic = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.assets.BatchCreateAssetsRequest} returns this
+ */
+proto.assets.BatchCreateAssetsRequest.prototype.setAtomic = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.assets.BatchUpdateAssetsRequest.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.BatchUpdateAssetsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.BatchUpdateAssetsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.BatchUpdateAssetsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.BatchUpdateAssetsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    requestsList: jspb.Message.toObjectList(msg.getRequestsList(),
+    proto.assets.UpdateAssetRequest.toObject, includeInstance),
+    atomic: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.BatchUpdateAssetsRequest}
+ */
+proto.assets.BatchUpdateAssetsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.BatchUpdateAssetsRequest;
+  return proto.assets.BatchUpdateAssetsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.BatchUpdateAssetsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.BatchUpdateAssetsRequest}
+ */
+proto.assets.BatchUpdateAssetsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.assets.UpdateAssetRequest;
+      reader.readMessage(value,proto.assets.UpdateAssetRequest.deserializeBinaryFromReader);
+      msg.addRequests(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAtomic(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.BatchUpdateAssetsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.BatchUpdateAssetsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.BatchUpdateAssetsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.BatchUpdateAssetsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRequestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.assets.UpdateAssetRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getAtomic();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated UpdateAssetRequest requests = 1;
+ * @return {!Array<!proto.assets.UpdateAssetRequest>}
+ */
+proto.assets.BatchUpdateAssetsRequest.prototype.getRequestsList = function() {
+  return /** @type{!Array<!proto.assets.UpdateAssetRequest>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.assets.UpdateAssetRequest, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.assets.UpdateAssetRequest>} value
+ * @return {!proto.assets.BatchUpdateAssetsRequest} returns this
+*/
+proto.assets.BatchUpdateAssetsRequest.prototype.setRequestsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.assets.UpdateAssetRequest=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.assets.UpdateAssetRequest}
+ */
+proto.assets.BatchUpdateAssetsRequest.prototype.addRequests = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.assets.UpdateAssetRequest, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.assets.BatchUpdateAssetsRequest} returns this
+ */
+proto.assets.BatchUpdateAssetsRequest.prototype.clearRequestsList = function() {
+  return this.setRequestsList([]);
+};
+
+
+/**
+ * optional bool atomic = 2;
+ * @return {boolean}
+ */
+proto.assets.BatchUpdateAssetsRequest.prototype.getAtomic = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.assets.BatchUpdateAssetsRequest} returns this
+ */
+proto.assets.BatchUpdateAssetsRequest.prototype.setAtomic = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.assets.BatchDeleteAssetsRequest.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.BatchDeleteAssetsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.BatchDeleteAssetsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.BatchDeleteAssetsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.BatchDeleteAssetsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    requestsList: jspb.Message.toObjectList(msg.getRequestsList(),
+    proto.assets.DeleteAssetRequest.toObject, includeInstance),
+    atomic: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.BatchDeleteAssetsRequest}
+ */
+proto.assets.BatchDeleteAssetsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.BatchDeleteAssetsRequest;
+  return proto.assets.BatchDeleteAssetsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.BatchDeleteAssetsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.BatchDeleteAssetsRequest}
+ */
+proto.assets.BatchDeleteAssetsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.assets.DeleteAssetRequest;
+      reader.readMessage(value,proto.assets.DeleteAssetRequest.deserializeBinaryFromReader);
+      msg.addRequests(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAtomic(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.BatchDeleteAssetsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.BatchDeleteAssetsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.BatchDeleteAssetsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.BatchDeleteAssetsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRequestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.assets.DeleteAssetRequest.serializeBinaryToWriter
+    );
+  }
+  f = message.getAtomic();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated DeleteAssetRequest requests = 1;
+ * @return {!Array<!proto.assets.DeleteAssetRequest>}
+ */
+proto.assets.BatchDeleteAssetsRequest.prototype.getRequestsList = function() {
+  return /** @type{!Array<!proto.assets.DeleteAssetRequest>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.assets.DeleteAssetRequest, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.assets.DeleteAssetRequest>} value
+ * @return {!proto.assets.BatchDeleteAssetsRequest} returns this
+*/
+proto.assets.BatchDeleteAssetsRequest.prototype.setRequestsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.assets.DeleteAssetRequest=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.assets.DeleteAssetRequest}
+ */
+proto.assets.BatchDeleteAssetsRequest.prototype.addRequests = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.assets.DeleteAssetRequest, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.assets.BatchDeleteAssetsRequest} returns this
+ */
+proto.assets.BatchDeleteAssetsRequest.prototype.clearRequestsList = function() {
+  return this.setRequestsList([]);
+};
+
+
+/**
+ * optional bool atomic = 2;
+ * @return {boolean}
+ */
+proto.assets.BatchDeleteAssetsRequest.prototype.getAtomic = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.assets.BatchDeleteAssetsRequest} returns this
+ */
+proto.assets.BatchDeleteAssetsRequest.prototype.setAtomic = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.BatchItemResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.BatchItemResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.BatchItemResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.BatchItemResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    index: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    code: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    message: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    asset: (f = msg.getAsset()) && proto.assets.Asset.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.BatchItemResult}
+ */
+proto.assets.BatchItemResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.BatchItemResult;
+  return proto.assets.BatchItemResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.BatchItemResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.BatchItemResult}
+ */
+proto.assets.BatchItemResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setIndex(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCode(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 4:
+      var value = new proto.assets.Asset;
+      reader.readMessage(value,proto.assets.Asset.deserializeBinaryFromReader);
+      msg.setAsset(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.BatchItemResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.BatchItemResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.BatchItemResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.BatchItemResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIndex();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getCode();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getAsset();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.assets.Asset.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int32 index = 1;
+ * @return {number}
+ */
+proto.assets.BatchItemResult.prototype.getIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.BatchItemResult} returns this
+ */
+proto.assets.BatchItemResult.prototype.setIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int32 code = 2;
+ * @return {number}
+ */
+proto.assets.BatchItemResult.prototype.getCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.BatchItemResult} returns this
+ */
+proto.assets.BatchItemResult.prototype.setCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string message = 3;
+ * @return {string}
+ */
+proto.assets.BatchItemResult.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.BatchItemResult} returns this
+ */
+proto.assets.BatchItemResult.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional Asset asset = 4;
+ * @return {?proto.assets.Asset}
+ */
+proto.assets.BatchItemResult.prototype.getAsset = function() {
+  return /** @type{?proto.assets.Asset} */ (
+    jspb.Message.getWrapperField(this, proto.assets.Asset, 4));
+};
+
+
+/**
+ * @param {?proto.assets.Asset|undefined} value
+ * @return {!proto.assets.BatchItemResult} returns this
+*/
+proto.assets.BatchItemResult.prototype.setAsset = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.assets.BatchItemResult} returns this
+ */
+proto.assets.BatchItemResult.prototype.clearAsset = function() {
+  return this.setAsset(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.assets.BatchItemResult.prototype.hasAsset = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.assets.BatchAssetsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.BatchAssetsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.BatchAssetsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.BatchAssetsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.BatchAssetsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resultsList: jspb.Message.toObjectList(msg.getResultsList(),
+    proto.assets.BatchItemResult.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.BatchAssetsResponse}
+ */
+proto.assets.BatchAssetsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.BatchAssetsResponse;
+  return proto.assets.BatchAssetsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.BatchAssetsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.BatchAssetsResponse}
+ */
+proto.assets.BatchAssetsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.assets.BatchItemResult;
+      reader.readMessage(value,proto.assets.BatchItemResult.deserializeBinaryFromReader);
+      msg.addResults(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.BatchAssetsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.BatchAssetsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.BatchAssetsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.BatchAssetsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResultsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.assets.BatchItemResult.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated BatchItemResult results = 1;
+ * @return {!Array<!proto.assets.BatchItemResult>}
+ */
+proto.assets.BatchAssetsResponse.prototype.getResultsList = function() {
+  return /** @type{!Array<!proto.assets.BatchItemResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.assets.BatchItemResult, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.assets.BatchItemResult>} value
+ * @return {!proto.assets.BatchAssetsResponse} returns this
+*/
+proto.assets.BatchAssetsResponse.prototype.setResultsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.assets.BatchItemResult=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.assets.BatchItemResult}
+ */
+proto.assets.BatchAssetsResponse.prototype.addResults = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.assets.BatchItemResult, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.assets.BatchAssetsResponse} returns this
+ */
+proto.assets.BatchAssetsResponse.prototype.clearResultsList = function() {
+  return this.setResultsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.AuditEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.AuditEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.AuditEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.AuditEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    actor: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    method: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    assetId: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    before: (f = msg.getBefore()) && proto.assets.Asset.toObject(includeInstance, f),
+    after: (f = msg.getAfter()) && proto.assets.Asset.toObject(includeInstance, f),
+    clientAddress: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    requestId: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    owner: jspb.Message.getFieldWithDefault(msg, 10, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.AuditEvent}
+ */
+proto.assets.AuditEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.AuditEvent;
+  return proto.assets.AuditEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.AuditEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.AuditEvent}
+ */
+proto.assets.AuditEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTime(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setActor(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMethod(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAssetId(value);
+      break;
+    case 6:
+      var value = new proto.assets.Asset;
+      reader.readMessage(value,proto.assets.Asset.deserializeBinaryFromReader);
+      msg.setBefore(value);
+      break;
+    case 7:
+      var value = new proto.assets.Asset;
+      reader.readMessage(value,proto.assets.Asset.deserializeBinaryFromReader);
+      msg.setAfter(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClientAddress(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRequestId(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.AuditEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.AuditEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.AuditEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.AuditEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTime();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getActor();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getMethod();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getAssetId();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getBefore();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.assets.Asset.serializeBinaryToWriter
+    );
+  }
+  f = message.getAfter();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.assets.Asset.serializeBinaryToWriter
+    );
+  }
+  f = message.getClientAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getRequestId();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.assets.AuditEvent.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.AuditEvent} returns this
+ */
+proto.assets.AuditEvent.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp time = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.assets.AuditEvent.prototype.getTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.assets.AuditEvent} returns this
+*/
+proto.assets.AuditEvent.prototype.setTime = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.assets.AuditEvent} returns this
+ */
+proto.assets.AuditEvent.prototype.clearTime = function() {
+  return this.setTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.assets.AuditEvent.prototype.hasTime = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string actor = 3;
+ * @return {string}
+ */
+proto.assets.AuditEvent.prototype.getActor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.AuditEvent} returns this
+ */
+proto.assets.AuditEvent.prototype.setActor = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string method = 4;
+ * @return {string}
+ */
+proto.assets.AuditEvent.prototype.getMethod = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.AuditEvent} returns this
+ */
+proto.assets.AuditEvent.prototype.setMethod = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string asset_id = 5;
+ * @return {string}
+ */
+proto.assets.AuditEvent.prototype.getAssetId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.AuditEvent} returns this
+ */
+proto.assets.AuditEvent.prototype.setAssetId = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional Asset before = 6;
+ * @return {?proto.assets.Asset}
+ */
+proto.assets.AuditEvent.prototype.getBefore = function() {
+  return /** @type{?proto.assets.Asset} */ (
+    jspb.Message.getWrapperField(this, proto.assets.Asset, 6));
+};
+
+
+/**
+ * @param {?proto.assets.Asset|undefined} value
+ * @return {!proto.assets.AuditEvent} returns this
+*/
+proto.assets.AuditEvent.prototype.setBefore = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.assets.AuditEvent} returns this
+ */
+proto.assets.AuditEvent.prototype.clearBefore = function() {
+  return this.setBefore(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.assets.AuditEvent.prototype.hasBefore = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional Asset after = 7;
+ * @return {?proto.assets.Asset}
+ */
+proto.assets.AuditEvent.prototype.getAfter = function() {
+  return /** @type{?proto.assets.Asset} */ (
+    jspb.Message.getWrapperField(this, proto.assets.Asset, 7));
+};
+
+
+/**
+ * @param {?proto.assets.Asset|undefined} value
+ * @return {!proto.assets.AuditEvent} returns this
+*/
+proto.assets.AuditEvent.prototype.setAfter = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.assets.AuditEvent} returns this
+ */
+proto.assets.AuditEvent.prototype.clearAfter = function() {
+  return this.setAfter(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.assets.UpdateAssetRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.assets.UpdateAssetRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.assets.AuditEvent.prototype.hasAfter = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * optional string symbol = 2;
+ * optional string client_address = 8;
  * @return {string}
  */
-proto.assets.UpdateAssetRequest.prototype.getSymbol = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.assets.AuditEvent.prototype.getClientAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.assets.UpdateAssetRequest} returns this
+ * @return {!proto.assets.AuditEvent} returns this
  */
-proto.assets.UpdateAssetRequest.prototype.setSymbol = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.assets.AuditEvent.prototype.setClientAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
 /**
- * optional int32 quantity = 3;
- * @return {number}
+ * optional string request_id = 9;
+ * @return {string}
  */
-proto.assets.UpdateAssetRequest.prototype.getQuantity = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.assets.AuditEvent.prototype.getRequestId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.assets.UpdateAssetRequest} returns this
+ * @param {string} value
+ * @return {!proto.assets.AuditEvent} returns this
  */
-proto.assets.UpdateAssetRequest.prototype.setQuantity = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.assets.AuditEvent.prototype.setRequestId = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
 /**
- * optional double price = 4;
- * @return {number}
+ * optional string owner = 10;
+ * @return {string}
  */
-proto.assets.UpdateAssetRequest.prototype.getPrice = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+proto.assets.AuditEvent.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.assets.UpdateAssetRequest} returns this
+ * @param {string} value
+ * @return {!proto.assets.AuditEvent} returns this
  */
-proto.assets.UpdateAssetRequest.prototype.setPrice = function(value) {
-  return jspb.Message.setProto3FloatField(this, 4, value);
+proto.assets.AuditEvent.prototype.setOwner = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
 };
 
 
@@ -951,8 +4181,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.assets.DeleteAssetRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.assets.DeleteAssetRequest.toObject(opt_includeInstance, this);
+proto.assets.ListAuditEventsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.ListAuditEventsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -961,13 +4191,18 @@ proto.assets.DeleteAssetRequest.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.assets.DeleteAssetRequest} msg The msg instance to transform.
+ * @param {!proto.assets.ListAuditEventsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.assets.DeleteAssetRequest.toObject = function(includeInstance, msg) {
+proto.assets.ListAuditEventsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    assetId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    startTime: (f = msg.getStartTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    endTime: (f = msg.getEndTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    pageToken: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    owner: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -981,23 +4216,23 @@ proto.assets.DeleteAssetRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.assets.DeleteAssetRequest}
+ * @return {!proto.assets.ListAuditEventsRequest}
  */
-proto.assets.DeleteAssetRequest.deserializeBinary = function(bytes) {
+proto.assets.ListAuditEventsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.assets.DeleteAssetRequest;
-  return proto.assets.DeleteAssetRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.assets.ListAuditEventsRequest;
+  return proto.assets.ListAuditEventsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.assets.DeleteAssetRequest} msg The message object to deserialize into.
+ * @param {!proto.assets.ListAuditEventsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.assets.DeleteAssetRequest}
+ * @return {!proto.assets.ListAuditEventsRequest}
  */
-proto.assets.DeleteAssetRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.assets.ListAuditEventsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1006,7 +4241,29 @@ proto.assets.DeleteAssetRequest.deserializeBinaryFromReader = function(msg, read
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setAssetId(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartTime(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setEndTime(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPageToken(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
       break;
     default:
       reader.skipField();
@@ -1021,9 +4278,9 @@ proto.assets.DeleteAssetRequest.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.assets.DeleteAssetRequest.prototype.serializeBinary = function() {
+proto.assets.ListAuditEventsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.assets.DeleteAssetRequest.serializeBinaryToWriter(this, writer);
+  proto.assets.ListAuditEventsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1031,138 +4288,202 @@ proto.assets.DeleteAssetRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.assets.DeleteAssetRequest} message
+ * @param {!proto.assets.ListAuditEventsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.assets.DeleteAssetRequest.serializeBinaryToWriter = function(message, writer) {
+proto.assets.ListAuditEventsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getAssetId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getStartTime();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getEndTime();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string asset_id = 1;
  * @return {string}
  */
-proto.assets.DeleteAssetRequest.prototype.getId = function() {
+proto.assets.ListAuditEventsRequest.prototype.getAssetId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.assets.DeleteAssetRequest} returns this
+ * @return {!proto.assets.ListAuditEventsRequest} returns this
  */
-proto.assets.DeleteAssetRequest.prototype.setId = function(value) {
+proto.assets.ListAuditEventsRequest.prototype.setAssetId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional google.protobuf.Timestamp start_time = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.assets.ListAuditEventsRequest.prototype.getStartTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
 
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.assets.ListAuditEventsRequest} returns this
+*/
+proto.assets.ListAuditEventsRequest.prototype.setStartTime = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the message field making it undefined.
+ * @return {!proto.assets.ListAuditEventsRequest} returns this
  */
-proto.assets.Empty.prototype.toObject = function(opt_includeInstance) {
-  return proto.assets.Empty.toObject(opt_includeInstance, this);
+proto.assets.ListAuditEventsRequest.prototype.clearStartTime = function() {
+  return this.setStartTime(undefined);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.assets.Empty} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.assets.Empty.toObject = function(includeInstance, msg) {
-  var f, obj = {
+proto.assets.ListAuditEventsRequest.prototype.hasStartTime = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
 
-  };
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+/**
+ * optional google.protobuf.Timestamp end_time = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.assets.ListAuditEventsRequest.prototype.getEndTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.assets.Empty}
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.assets.ListAuditEventsRequest} returns this
+*/
+proto.assets.ListAuditEventsRequest.prototype.setEndTime = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.assets.ListAuditEventsRequest} returns this
  */
-proto.assets.Empty.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.assets.Empty;
-  return proto.assets.Empty.deserializeBinaryFromReader(msg, reader);
+proto.assets.ListAuditEventsRequest.prototype.clearEndTime = function() {
+  return this.setEndTime(undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.assets.Empty} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.assets.Empty}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.assets.Empty.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.assets.ListAuditEventsRequest.prototype.hasEndTime = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional int32 page_size = 4;
+ * @return {number}
  */
-proto.assets.Empty.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.assets.Empty.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.assets.ListAuditEventsRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.assets.Empty} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.assets.ListAuditEventsRequest} returns this
  */
-proto.assets.Empty.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.assets.ListAuditEventsRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string page_token = 5;
+ * @return {string}
+ */
+proto.assets.ListAuditEventsRequest.prototype.getPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.ListAuditEventsRequest} returns this
+ */
+proto.assets.ListAuditEventsRequest.prototype.setPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string owner = 6;
+ * @return {string}
+ */
+proto.assets.ListAuditEventsRequest.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.ListAuditEventsRequest} returns this
+ */
+proto.assets.ListAuditEventsRequest.prototype.setOwner = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
@@ -1172,7 +4493,7 @@ proto.assets.Empty.serializeBinaryToWriter = function(message, writer) {
  * @private {!Array<number>}
  * @const
  */
-proto.assets.AssetList.repeatedFields_ = [1];
+proto.assets.ListAuditEventsResponse.repeatedFields_ = [1];
 
 
 
@@ -1189,8 +4510,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.assets.AssetList.prototype.toObject = function(opt_includeInstance) {
-  return proto.assets.AssetList.toObject(opt_includeInstance, this);
+proto.assets.ListAuditEventsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.ListAuditEventsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1199,14 +4520,15 @@ proto.assets.AssetList.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.assets.AssetList} msg The msg instance to transform.
+ * @param {!proto.assets.ListAuditEventsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.assets.AssetList.toObject = function(includeInstance, msg) {
+proto.assets.ListAuditEventsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    assetsList: jspb.Message.toObjectList(msg.getAssetsList(),
-    proto.assets.Asset.toObject, includeInstance)
+    eventsList: jspb.Message.toObjectList(msg.getEventsList(),
+    proto.assets.AuditEvent.toObject, includeInstance),
+    nextPageToken: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -1220,23 +4542,23 @@ proto.assets.AssetList.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.assets.AssetList}
+ * @return {!proto.assets.ListAuditEventsResponse}
  */
-proto.assets.AssetList.deserializeBinary = function(bytes) {
+proto.assets.ListAuditEventsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.assets.AssetList;
-  return proto.assets.AssetList.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.assets.ListAuditEventsResponse;
+  return proto.assets.ListAuditEventsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.assets.AssetList} msg The message object to deserialize into.
+ * @param {!proto.assets.ListAuditEventsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.assets.AssetList}
+ * @return {!proto.assets.ListAuditEventsResponse}
  */
-proto.assets.AssetList.deserializeBinaryFromReader = function(msg, reader) {
+proto.assets.ListAuditEventsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1244,9 +4566,13 @@ proto.assets.AssetList.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.assets.Asset;
-      reader.readMessage(value,proto.assets.Asset.deserializeBinaryFromReader);
-      msg.addAssets(value);
+      var value = new proto.assets.AuditEvent;
+      reader.readMessage(value,proto.assets.AuditEvent.deserializeBinaryFromReader);
+      msg.addEvents(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextPageToken(value);
       break;
     default:
       reader.skipField();
@@ -1261,9 +4587,9 @@ proto.assets.AssetList.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.assets.AssetList.prototype.serializeBinary = function() {
+proto.assets.ListAuditEventsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.assets.AssetList.serializeBinaryToWriter(this, writer);
+  proto.assets.ListAuditEventsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1271,58 +4597,83 @@ proto.assets.AssetList.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.assets.AssetList} message
+ * @param {!proto.assets.ListAuditEventsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.assets.AssetList.serializeBinaryToWriter = function(message, writer) {
+proto.assets.ListAuditEventsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAssetsList();
+  f = message.getEventsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.assets.Asset.serializeBinaryToWriter
+      proto.assets.AuditEvent.serializeBinaryToWriter
+    );
+  }
+  f = message.getNextPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated Asset assets = 1;
- * @return {!Array<!proto.assets.Asset>}
+ * repeated AuditEvent events = 1;
+ * @return {!Array<!proto.assets.AuditEvent>}
  */
-proto.assets.AssetList.prototype.getAssetsList = function() {
-  return /** @type{!Array<!proto.assets.Asset>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.assets.Asset, 1));
+proto.assets.ListAuditEventsResponse.prototype.getEventsList = function() {
+  return /** @type{!Array<!proto.assets.AuditEvent>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.assets.AuditEvent, 1));
 };
 
 
 /**
- * @param {!Array<!proto.assets.Asset>} value
- * @return {!proto.assets.AssetList} returns this
+ * @param {!Array<!proto.assets.AuditEvent>} value
+ * @return {!proto.assets.ListAuditEventsResponse} returns this
 */
-proto.assets.AssetList.prototype.setAssetsList = function(value) {
+proto.assets.ListAuditEventsResponse.prototype.setEventsList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.assets.Asset=} opt_value
+ * @param {!proto.assets.AuditEvent=} opt_value
  * @param {number=} opt_index
- * @return {!proto.assets.Asset}
+ * @return {!proto.assets.AuditEvent}
  */
-proto.assets.AssetList.prototype.addAssets = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.assets.Asset, opt_index);
+proto.assets.ListAuditEventsResponse.prototype.addEvents = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.assets.AuditEvent, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.assets.AssetList} returns this
+ * @return {!proto.assets.ListAuditEventsResponse} returns this
  */
-proto.assets.AssetList.prototype.clearAssetsList = function() {
-  return this.setAssetsList([]);
+proto.assets.ListAuditEventsResponse.prototype.clearEventsList = function() {
+  return this.setEventsList([]);
+};
+
+
+/**
+ * optional string next_page_token = 2;
+ * @return {string}
+ */
+proto.assets.ListAuditEventsResponse.prototype.getNextPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.ListAuditEventsResponse} returns this
+ */
+proto.assets.ListAuditEventsResponse.prototype.setNextPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -0,0 +1,528 @@
+// source: proto/validate.proto
+/**
+ * @fileoverview
+ * @enhanceable
+ * @suppress {missingRequire} reports error on implicit type usages.
+ * @suppress {messageConventions} JS Compiler reports an error if a variable or
+ *     field starts with 'MSG_' and isn't a translatable message.
+ * @public
+ */
+// GENERATED CODE -- DO NOT EDIT!
+/* eslint-disable */
+// @ts-nocheck
+
+var jspb = require('google-protobuf');
+var goog = jspb;
+var global =
+    (typeof globalThis !== 'undefined' && globalThis) ||
+    (typeof window !== 'undefined' && window) ||
+    (typeof global !== 'undefined' && global) ||
+    (typeof self !== 'undefined' && self) ||
+    (function () { return this; }).call(null) ||
+    Function('return this')();
+
+var google_protobuf_descriptor_pb = require('google-protobuf/google/protobuf/descriptor_pb.js');
+goog.object.extend(proto, google_protobuf_descriptor_pb);
+goog.exportSymbol('proto.assets.FieldRules', null, global);
+goog.exportSymbol('proto.assets.rules', null, global);
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.assets.FieldRules = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.assets.FieldRules, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.assets.FieldRules.displayName = 'proto.assets.FieldRules';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.assets.FieldRules.prototype.toObject = function(opt_includeInstance) {
+  return proto.assets.FieldRules.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.assets.FieldRules} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.FieldRules.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    required: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    minLen: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    maxLen: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    pattern: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    objectId: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    gte: (f = jspb.Message.getOptionalFloatingPointField(msg, 6)) == null ? undefined : f,
+    gt: (f = jspb.Message.getOptionalFloatingPointField(msg, 7)) == null ? undefined : f,
+    lte: (f = jspb.Message.getOptionalFloatingPointField(msg, 8)) == null ? undefined : f,
+    finite: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
+    dive: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.assets.FieldRules}
+ */
+proto.assets.FieldRules.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.assets.FieldRules;
+  return proto.assets.FieldRules.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.assets.FieldRules} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.assets.FieldRules}
+ */
+proto.assets.FieldRules.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRequired(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMinLen(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxLen(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPattern(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setObjectId(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setGte(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setGt(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setLte(value);
+      break;
+    case 9:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFinite(value);
+      break;
+    case 10:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDive(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.assets.FieldRules.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.assets.FieldRules.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.assets.FieldRules} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.assets.FieldRules.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRequired();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getMinLen();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getMaxLen();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getPattern();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getObjectId();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeDouble(
+      6,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeDouble(
+      7,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeDouble(
+      8,
+      f
+    );
+  }
+  f = message.getFinite();
+  if (f) {
+    writer.writeBool(
+      9,
+      f
+    );
+  }
+  f = message.getDive();
+  if (f) {
+    writer.writeBool(
+      10,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool required = 1;
+ * @return {boolean}
+ */
+proto.assets.FieldRules.prototype.getRequired = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.setRequired = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 min_len = 2;
+ * @return {number}
+ */
+proto.assets.FieldRules.prototype.getMinLen = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.setMinLen = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 max_len = 3;
+ * @return {number}
+ */
+proto.assets.FieldRules.prototype.getMaxLen = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.setMaxLen = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string pattern = 4;
+ * @return {string}
+ */
+proto.assets.FieldRules.prototype.getPattern = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.setPattern = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional bool object_id = 5;
+ * @return {boolean}
+ */
+proto.assets.FieldRules.prototype.getObjectId = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.setObjectId = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional double gte = 6;
+ * @return {number}
+ */
+proto.assets.FieldRules.prototype.getGte = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 6, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.setGte = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.clearGte = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.assets.FieldRules.prototype.hasGte = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional double gt = 7;
+ * @return {number}
+ */
+proto.assets.FieldRules.prototype.getGt = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 7, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.setGt = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.clearGt = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.assets.FieldRules.prototype.hasGt = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional double lte = 8;
+ * @return {number}
+ */
+proto.assets.FieldRules.prototype.getLte = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 8, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.setLte = function(value) {
+  return jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.clearLte = function() {
+  return jspb.Message.setField(this, 8, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.assets.FieldRules.prototype.hasLte = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional bool finite = 9;
+ * @return {boolean}
+ */
+proto.assets.FieldRules.prototype.getFinite = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.setFinite = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 9, value);
+};
+
+
+/**
+ * optional bool dive = 10;
+ * @return {boolean}
+ */
+proto.assets.FieldRules.prototype.getDive = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.assets.FieldRules} returns this
+ */
+proto.assets.FieldRules.prototype.setDive = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 10, value);
+};
+
+
+
+/**
+ * A tuple of {field number, class constructor} for the extension
+ * field named `rules`.
+ * @type {!jspb.ExtensionFieldInfo<!proto.assets.FieldRules>}
+ */
+proto.assets.rules = new jspb.ExtensionFieldInfo(
+    50001,
+    {rules: 0},
+    proto.assets.FieldRules,
+     /** @type {?function((boolean|undefined),!jspb.Message=): !Object} */ (
+         proto.assets.FieldRules.toObject),
+    0);
+
+google_protobuf_descriptor_pb.FieldOptions.extensionsBinary[50001] = new jspb.ExtensionFieldBinaryInfo(
+    proto.assets.rules,
+    jspb.BinaryReader.prototype.readMessage,
+    jspb.BinaryWriter.prototype.writeMessage,
+    proto.assets.FieldRules.serializeBinaryToWriter,
+    proto.assets.FieldRules.deserializeBinaryFromReader,
+    false);
+// This registers the extension field with the extended class, so that
+// toObject() will function correctly.
+google_protobuf_descriptor_pb.FieldOptions.extensions[50001] = proto.assets.rules;
+
+goog.object.extend(exports, proto.assets);
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/auth"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/rbac"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/users"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// accountMethods can be called without a bearer token.
+var accountMethods = []string{
+	asset.AuthService_Register_FullMethodName,
+	asset.AuthService_Login_FullMethodName,
+	asset.AuthService_Refresh_FullMethodName,
+	asset.AuthService_Logout_FullMethodName,
+}
+
+type authServer struct {
+	asset.UnimplementedAuthServiceServer
+	users  *users.Store
+	issuer *auth.Issuer
+	// refreshTTL is how long refresh tokens stay valid.
+	refreshTTL time.Duration
+	// allowRegistration lets callers create their own accounts.
+	allowRegistration bool
+}
+
+// Register creates an editor account, which can manage its own portfolio.
+// It fails with PermissionDenied when registration is closed.
+func (s *authServer) Register(ctx context.Context, req *asset.RegisterRequest) (*asset.Session, error) {
+	if !s.allowRegistration {
+		return nil, status.Error(codes.PermissionDenied, "registration is closed, ask an administrator for an account")
+	}
+	user, err := s.users.Create(ctx, req.Username, req.Password, string(rbac.Editor), writeTime())
+	if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+		return nil, grpcerr.InvalidArgument("password", "must be at most 72 bytes")
+	}
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "user", req.Username)
+	}
+	return s.newSession(ctx, user)
+}
+
+func (s *authServer) Login(ctx context.Context, req *asset.LoginRequest) (*asset.Session, error) {
+	user, err := s.users.Authenticate(ctx, req.Username, req.Password)
+	if err == users.ErrInvalidCredentials {
+		return nil, grpcerr.Unauthenticated("invalid username or password")
+	}
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "user", req.Username)
+	}
+	return s.newSession(ctx, user)
+}
+
+// Refresh trades a refresh token for a new session with the current role of
+// the user. The old refresh token stops working.
+func (s *authServer) Refresh(ctx context.Context, req *asset.RefreshRequest) (*asset.Session, error) {
+	userID, err := s.users.ConsumeRefreshToken(ctx, req.RefreshToken, time.Now())
+	if err == users.ErrInvalidCredentials {
+		return nil, grpcerr.Unauthenticated("invalid refresh token")
+	}
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "session", "")
+	}
+	user, err := s.users.Get(ctx, userID)
+	if err == mongo.ErrNoDocuments {
+		return nil, grpcerr.Unauthenticated("invalid refresh token")
+	}
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "user", userID.Hex())
+	}
+	return s.newSession(ctx, user)
+}
+
+// Logout revokes a refresh token. Access tokens already issued stay valid
+// until they expire.
+func (s *authServer) Logout(ctx context.Context, req *asset.LogoutRequest) (*asset.Empty, error) {
+	if err := s.users.RevokeRefreshToken(ctx, req.RefreshToken); err != nil {
+		return nil, grpcerr.FromMongo(err, "session", "")
+	}
+	return &asset.Empty{}, nil
+}
+
+// SetUserRole changes the role of a user.
+func (s *authServer) SetUserRole(ctx context.Context, req *asset.SetUserRoleRequest) (*asset.Empty, error) {
+	if err := s.users.SetRole(ctx, req.Username, req.Role); err != nil {
+		return nil, grpcerr.FromMongo(err, "user", req.Username)
+	}
+	return &asset.Empty{}, nil
+}
+
+func (s *authServer) newSession(ctx context.Context, user *users.User) (*asset.Session, error) {
+	now := time.Now()
+	userID := user.ID
+	accessToken, err := s.issuer.Issue(userID.Hex(), []string{user.Role}, now)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := s.users.IssueRefreshToken(ctx, userID, now.Add(s.refreshTTL))
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "session", "")
+	}
+	return &asset.Session{
+		UserId:       userID.Hex(),
+		AccessToken:  accessToken,
+		ExpiresIn:    int64(s.issuer.TTL() / time.Second),
+		RefreshToken: refreshToken,
+	}, nil
+}
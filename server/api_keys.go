@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/apikeys"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/principal"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/rbac"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type apiKeyServer struct {
+	asset.UnimplementedApiKeyServiceServer
+	keys *apikeys.Store
+}
+
+// CreateApiKey mints a key acting as the caller, limited to the requested
+// scopes.
+func (s *apiKeyServer) CreateApiKey(ctx context.Context, req *asset.CreateApiKeyRequest) (*asset.CreateApiKeyResponse, error) {
+	var violations []grpcerr.FieldViolation
+	for i, scope := range req.Scopes {
+		if !rbac.ValidScope(scope) {
+			violations = append(violations, grpcerr.FieldViolation{
+				Field:       fmt.Sprintf("scopes[%d]", i),
+				Description: fmt.Sprintf("unknown scope %q", scope),
+			})
+		}
+	}
+	now := writeTime()
+	var expires *time.Time
+	if req.ExpireTime != nil {
+		t := req.ExpireTime.AsTime()
+		if !t.After(now) {
+			violations = append(violations, grpcerr.FieldViolation{Field: "expire_time", Description: "must be in the future"})
+		}
+		expires = &t
+	}
+	if len(violations) > 0 {
+		return nil, grpcerr.BadRequest(violations...)
+	}
+	p, _ := principal.FromContext(ctx)
+	key, secret, err := s.keys.Create(ctx, p, req.Name, req.Scopes, now, expires)
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "API key", req.Name)
+	}
+	return &asset.CreateApiKeyResponse{ApiKey: apiKeyToProto(key), Key: secret}, nil
+}
+
+// ListApiKeys lists the keys of the caller. Secrets are never returned.
+func (s *apiKeyServer) ListApiKeys(ctx context.Context, _ *asset.Empty) (*asset.ApiKeyList, error) {
+	keys, err := s.keys.List(ctx, principal.Name(ctx))
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "API key", "")
+	}
+	list := &asset.ApiKeyList{}
+	for i := range keys {
+		list.ApiKeys = append(list.ApiKeys, apiKeyToProto(&keys[i]))
+	}
+	return list, nil
+}
+
+func (s *apiKeyServer) RevokeApiKey(ctx context.Context, req *asset.RevokeApiKeyRequest) (*asset.Empty, error) {
+	id, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, grpcerr.InvalidID("id", req.Id)
+	}
+	if err := s.keys.Revoke(ctx, principal.Name(ctx), id); err != nil {
+		return nil, grpcerr.FromMongo(err, "API key", req.Id)
+	}
+	return &asset.Empty{}, nil
+}
+
+func apiKeyToProto(key *apikeys.Key) *asset.ApiKey {
+	k := &asset.ApiKey{
+		Id:         key.ID.Hex(),
+		Name:       key.Name,
+		Scopes:     key.Scopes,
+		CreateTime: timestamppb.New(key.CreatedAt),
+	}
+	if key.ExpiresAt != nil {
+		k.ExpireTime = timestamppb.New(*key.ExpiresAt)
+	}
+	return k
+}
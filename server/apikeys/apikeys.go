@@ -0,0 +1,160 @@
+// Package apikeys stores API keys. Only a SHA-256 hash of each secret key is
+// kept, so a key cannot be recovered from the database, only recognized.
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/auth"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/principal"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/rbac"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// prefix starts every key so that leaked keys are easy to spot.
+const prefix = "apk_"
+
+// Key is the stored form of an API key. It acts as Owner with the roles
+// Owner had when creating it, limited to Scopes and, when the Store knows
+// Owner's current roles, to the permissions those grant.
+type Key struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Hash      []byte             `bson:"hash"`
+	Owner     string             `bson:"owner"`
+	Name      string             `bson:"name"`
+	Roles     []string           `bson:"roles"`
+	Scopes    []string           `bson:"scopes"`
+	CreatedAt time.Time          `bson:"created_at"`
+	ExpiresAt *time.Time         `bson:"expires_at"`
+}
+
+// Owners looks up the current roles of the owners of keys.
+type Owners interface {
+	// Roles returns the roles of subject. It returns mongo.ErrNoDocuments
+	// if subject is not an account it knows.
+	Roles(ctx context.Context, subject string) ([]string, error)
+}
+
+// Store reads and writes API keys.
+type Store struct {
+	keys *mongo.Collection
+	// Owners, if not nil, limits every key to the permissions the current
+	// roles of its owner grant, so that a key loses what its owner loses.
+	// Keys of owners it does not know keep the roles they were created
+	// with.
+	Owners Owners
+}
+
+func NewStore(keys *mongo.Collection) *Store {
+	return &Store{keys: keys}
+}
+
+// Create stores a new key for the caller p and returns it along with the
+// secret to hand out. expires may be nil.
+func (s *Store) Create(ctx context.Context, p *principal.Principal, name string, scopes []string, now time.Time, expires *time.Time) (*Key, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", err
+	}
+	secret := prefix + base64.RawURLEncoding.EncodeToString(b)
+	key := &Key{
+		ID:        primitive.NewObjectID(),
+		Hash:      hash(secret),
+		Owner:     p.Subject,
+		Name:      name,
+		Roles:     p.Roles,
+		Scopes:    scopes,
+		CreatedAt: now,
+		ExpiresAt: expires,
+	}
+	if _, err := s.keys.InsertOne(ctx, key); err != nil {
+		return nil, "", err
+	}
+	return key, secret, nil
+}
+
+// List returns the keys of owner, newest first.
+func (s *Store) List(ctx context.Context, owner string) ([]Key, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: -1}})
+	cursor, err := s.keys.Find(ctx, bson.M{"owner": owner}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var keys []Key
+	if err := cursor.All(ctx, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Revoke deletes the key of owner with the given id. It returns
+// mongo.ErrNoDocuments if there is none.
+func (s *Store) Revoke(ctx context.Context, owner string, id primitive.ObjectID) error {
+	res, err := s.keys.DeleteOne(ctx, bson.M{"_id": id, "owner": owner})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// Principal returns the caller a secret key authenticates. Unknown, revoked
+// and expired keys alike fail with auth.ErrInvalidAPIKey.
+func (s *Store) Principal(ctx context.Context, secret string) (*principal.Principal, error) {
+	var key Key
+	err := s.keys.FindOne(ctx, bson.M{
+		"hash": hash(secret),
+		"$or": bson.A{
+			bson.M{"expires_at": nil},
+			bson.M{"expires_at": bson.M{"$gt": time.Now()}},
+		},
+	}).Decode(&key)
+	if err == mongo.ErrNoDocuments {
+		return nil, auth.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	scopes := key.Scopes
+	if scopes == nil {
+		// nil would mean no limit.
+		scopes = []string{}
+	}
+	p := &principal.Principal{Subject: key.Owner, Roles: key.Roles, Scopes: scopes}
+	if s.Owners != nil {
+		current, err := s.Owners.Roles(ctx, key.Owner)
+		switch {
+		case err == nil:
+			p.Permissions = intersect(key.Roles, current)
+		case err != mongo.ErrNoDocuments:
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// intersect returns the permissions granted both by keyRoles, the roles a
+// key was created with, and by ownerRoles, the current roles of its owner.
+func intersect(keyRoles, ownerRoles []string) []string {
+	perms := []string{}
+	for _, perm := range rbac.Permissions(keyRoles) {
+		if rbac.Allowed(ownerRoles, perm) {
+			perms = append(perms, string(perm))
+		}
+	}
+	return perms
+}
+
+func hash(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
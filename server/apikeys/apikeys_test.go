@@ -0,0 +1,59 @@
+package apikeys
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIntersect(t *testing.T) {
+	tests := []struct {
+		name       string
+		keyRoles   []string
+		ownerRoles []string
+		want       []string
+	}{
+		{
+			name:       "unchanged editor",
+			keyRoles:   []string{"editor"},
+			ownerRoles: []string{"editor"},
+			want:       []string{"assets.read", "assets.write", "shares.manage", "apikeys.manage", "audit.read"},
+		},
+		{
+			name:       "editor promoted to admin",
+			keyRoles:   []string{"editor"},
+			ownerRoles: []string{"admin"},
+			want:       []string{"assets.read", "assets.write", "shares.manage", "apikeys.manage", "audit.read"},
+		},
+		{
+			name:       "editor demoted to viewer",
+			keyRoles:   []string{"editor"},
+			ownerRoles: []string{"viewer"},
+			want:       []string{"assets.read", "apikeys.manage"},
+		},
+		{
+			name:       "admin demoted to editor",
+			keyRoles:   []string{"admin"},
+			ownerRoles: []string{"editor"},
+			want:       []string{"assets.read", "assets.write", "shares.manage", "apikeys.manage", "audit.read"},
+		},
+		{
+			name:       "owner lost every role",
+			keyRoles:   []string{"editor"},
+			ownerRoles: nil,
+			want:       []string{},
+		},
+		{
+			name:       "unknown owner role",
+			keyRoles:   []string{"viewer"},
+			ownerRoles: []string{"auditor"},
+			want:       []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := intersect(tt.keyRoles, tt.ownerRoles); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("intersect(%q, %q) = %q, want %q", tt.keyRoles, tt.ownerRoles, got, tt.want)
+			}
+		})
+	}
+}
@@ -19,11 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AssetService_CreateAsset_FullMethodName = "/assets.AssetService/CreateAsset"
-	AssetService_GetAsset_FullMethodName    = "/assets.AssetService/GetAsset"
-	AssetService_UpdateAsset_FullMethodName = "/assets.AssetService/UpdateAsset"
-	AssetService_DeleteAsset_FullMethodName = "/assets.AssetService/DeleteAsset"
-	AssetService_ListAssets_FullMethodName  = "/assets.AssetService/ListAssets"
+	AssetService_CreateAsset_FullMethodName       = "/assets.AssetService/CreateAsset"
+	AssetService_GetAsset_FullMethodName          = "/assets.AssetService/GetAsset"
+	AssetService_UpdateAsset_FullMethodName       = "/assets.AssetService/UpdateAsset"
+	AssetService_DeleteAsset_FullMethodName       = "/assets.AssetService/DeleteAsset"
+	AssetService_ListAssets_FullMethodName        = "/assets.AssetService/ListAssets"
+	AssetService_BatchCreateAssets_FullMethodName = "/assets.AssetService/BatchCreateAssets"
+	AssetService_BatchUpdateAssets_FullMethodName = "/assets.AssetService/BatchUpdateAssets"
+	AssetService_BatchDeleteAssets_FullMethodName = "/assets.AssetService/BatchDeleteAssets"
+	AssetService_UpsertAsset_FullMethodName       = "/assets.AssetService/UpsertAsset"
+	AssetService_ListDeletedAssets_FullMethodName = "/assets.AssetService/ListDeletedAssets"
+	AssetService_RestoreAsset_FullMethodName      = "/assets.AssetService/RestoreAsset"
+	AssetService_PurgeAsset_FullMethodName        = "/assets.AssetService/PurgeAsset"
+	AssetService_ListAuditEvents_FullMethodName   = "/assets.AssetService/ListAuditEvents"
 )
 
 // AssetServiceClient is the client API for AssetService service.
@@ -34,7 +42,15 @@ type AssetServiceClient interface {
 	GetAsset(ctx context.Context, in *GetAssetRequest, opts ...grpc.CallOption) (*Asset, error)
 	UpdateAsset(ctx context.Context, in *UpdateAssetRequest, opts ...grpc.CallOption) (*Asset, error)
 	DeleteAsset(ctx context.Context, in *DeleteAssetRequest, opts ...grpc.CallOption) (*Empty, error)
-	ListAssets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AssetList, error)
+	ListAssets(ctx context.Context, in *ListAssetsRequest, opts ...grpc.CallOption) (*AssetList, error)
+	BatchCreateAssets(ctx context.Context, in *BatchCreateAssetsRequest, opts ...grpc.CallOption) (*BatchAssetsResponse, error)
+	BatchUpdateAssets(ctx context.Context, in *BatchUpdateAssetsRequest, opts ...grpc.CallOption) (*BatchAssetsResponse, error)
+	BatchDeleteAssets(ctx context.Context, in *BatchDeleteAssetsRequest, opts ...grpc.CallOption) (*BatchAssetsResponse, error)
+	UpsertAsset(ctx context.Context, in *UpsertAssetRequest, opts ...grpc.CallOption) (*Asset, error)
+	ListDeletedAssets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AssetList, error)
+	RestoreAsset(ctx context.Context, in *RestoreAssetRequest, opts ...grpc.CallOption) (*Asset, error)
+	PurgeAsset(ctx context.Context, in *PurgeAssetRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
 type assetServiceClient struct {
@@ -81,7 +97,7 @@ func (c *assetServiceClient) DeleteAsset(ctx context.Context, in *DeleteAssetReq
 	return out, nil
 }
 
-func (c *assetServiceClient) ListAssets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AssetList, error) {
+func (c *assetServiceClient) ListAssets(ctx context.Context, in *ListAssetsRequest, opts ...grpc.CallOption) (*AssetList, error) {
 	out := new(AssetList)
 	err := c.cc.Invoke(ctx, AssetService_ListAssets_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -90,6 +106,78 @@ func (c *assetServiceClient) ListAssets(ctx context.Context, in *Empty, opts ...
 	return out, nil
 }
 
+func (c *assetServiceClient) BatchCreateAssets(ctx context.Context, in *BatchCreateAssetsRequest, opts ...grpc.CallOption) (*BatchAssetsResponse, error) {
+	out := new(BatchAssetsResponse)
+	err := c.cc.Invoke(ctx, AssetService_BatchCreateAssets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetServiceClient) BatchUpdateAssets(ctx context.Context, in *BatchUpdateAssetsRequest, opts ...grpc.CallOption) (*BatchAssetsResponse, error) {
+	out := new(BatchAssetsResponse)
+	err := c.cc.Invoke(ctx, AssetService_BatchUpdateAssets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetServiceClient) BatchDeleteAssets(ctx context.Context, in *BatchDeleteAssetsRequest, opts ...grpc.CallOption) (*BatchAssetsResponse, error) {
+	out := new(BatchAssetsResponse)
+	err := c.cc.Invoke(ctx, AssetService_BatchDeleteAssets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetServiceClient) UpsertAsset(ctx context.Context, in *UpsertAssetRequest, opts ...grpc.CallOption) (*Asset, error) {
+	out := new(Asset)
+	err := c.cc.Invoke(ctx, AssetService_UpsertAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetServiceClient) ListDeletedAssets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AssetList, error) {
+	out := new(AssetList)
+	err := c.cc.Invoke(ctx, AssetService_ListDeletedAssets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetServiceClient) RestoreAsset(ctx context.Context, in *RestoreAssetRequest, opts ...grpc.CallOption) (*Asset, error) {
+	out := new(Asset)
+	err := c.cc.Invoke(ctx, AssetService_RestoreAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetServiceClient) PurgeAsset(ctx context.Context, in *PurgeAssetRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AssetService_PurgeAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, AssetService_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssetServiceServer is the server API for AssetService service.
 // All implementations must embed UnimplementedAssetServiceServer
 // for forward compatibility
@@ -98,7 +186,15 @@ type AssetServiceServer interface {
 	GetAsset(context.Context, *GetAssetRequest) (*Asset, error)
 	UpdateAsset(context.Context, *UpdateAssetRequest) (*Asset, error)
 	DeleteAsset(context.Context, *DeleteAssetRequest) (*Empty, error)
-	ListAssets(context.Context, *Empty) (*AssetList, error)
+	ListAssets(context.Context, *ListAssetsRequest) (*AssetList, error)
+	BatchCreateAssets(context.Context, *BatchCreateAssetsRequest) (*BatchAssetsResponse, error)
+	BatchUpdateAssets(context.Context, *BatchUpdateAssetsRequest) (*BatchAssetsResponse, error)
+	BatchDeleteAssets(context.Context, *BatchDeleteAssetsRequest) (*BatchAssetsResponse, error)
+	UpsertAsset(context.Context, *UpsertAssetRequest) (*Asset, error)
+	ListDeletedAssets(context.Context, *Empty) (*AssetList, error)
+	RestoreAsset(context.Context, *RestoreAssetRequest) (*Asset, error)
+	PurgeAsset(context.Context, *PurgeAssetRequest) (*Empty, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	mustEmbedUnimplementedAssetServiceServer()
 }
 
@@ -118,9 +214,33 @@ func (UnimplementedAssetServiceServer) UpdateAsset(context.Context, *UpdateAsset
 func (UnimplementedAssetServiceServer) DeleteAsset(context.Context, *DeleteAssetRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAsset not implemented")
 }
-func (UnimplementedAssetServiceServer) ListAssets(context.Context, *Empty) (*AssetList, error) {
+func (UnimplementedAssetServiceServer) ListAssets(context.Context, *ListAssetsRequest) (*AssetList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAssets not implemented")
 }
+func (UnimplementedAssetServiceServer) BatchCreateAssets(context.Context, *BatchCreateAssetsRequest) (*BatchAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateAssets not implemented")
+}
+func (UnimplementedAssetServiceServer) BatchUpdateAssets(context.Context, *BatchUpdateAssetsRequest) (*BatchAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateAssets not implemented")
+}
+func (UnimplementedAssetServiceServer) BatchDeleteAssets(context.Context, *BatchDeleteAssetsRequest) (*BatchAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteAssets not implemented")
+}
+func (UnimplementedAssetServiceServer) UpsertAsset(context.Context, *UpsertAssetRequest) (*Asset, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertAsset not implemented")
+}
+func (UnimplementedAssetServiceServer) ListDeletedAssets(context.Context, *Empty) (*AssetList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedAssets not implemented")
+}
+func (UnimplementedAssetServiceServer) RestoreAsset(context.Context, *RestoreAssetRequest) (*Asset, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAsset not implemented")
+}
+func (UnimplementedAssetServiceServer) PurgeAsset(context.Context, *PurgeAssetRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeAsset not implemented")
+}
+func (UnimplementedAssetServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedAssetServiceServer) mustEmbedUnimplementedAssetServiceServer() {}
 
 // UnsafeAssetServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -207,7 +327,7 @@ func _AssetService_DeleteAsset_Handler(srv interface{}, ctx context.Context, dec
 }
 
 func _AssetService_ListAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(ListAssetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -219,7 +339,151 @@ func _AssetService_ListAssets_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: AssetService_ListAssets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AssetServiceServer).ListAssets(ctx, req.(*Empty))
+		return srv.(AssetServiceServer).ListAssets(ctx, req.(*ListAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetService_BatchCreateAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServiceServer).BatchCreateAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetService_BatchCreateAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServiceServer).BatchCreateAssets(ctx, req.(*BatchCreateAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetService_BatchUpdateAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServiceServer).BatchUpdateAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetService_BatchUpdateAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServiceServer).BatchUpdateAssets(ctx, req.(*BatchUpdateAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetService_BatchDeleteAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServiceServer).BatchDeleteAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetService_BatchDeleteAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServiceServer).BatchDeleteAssets(ctx, req.(*BatchDeleteAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetService_UpsertAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServiceServer).UpsertAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetService_UpsertAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServiceServer).UpsertAsset(ctx, req.(*UpsertAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetService_ListDeletedAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServiceServer).ListDeletedAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetService_ListDeletedAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServiceServer).ListDeletedAssets(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetService_RestoreAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServiceServer).RestoreAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetService_RestoreAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServiceServer).RestoreAsset(ctx, req.(*RestoreAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetService_PurgeAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServiceServer).PurgeAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetService_PurgeAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServiceServer).PurgeAsset(ctx, req.(*PurgeAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -251,6 +515,38 @@ var AssetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAssets",
 			Handler:    _AssetService_ListAssets_Handler,
 		},
+		{
+			MethodName: "BatchCreateAssets",
+			Handler:    _AssetService_BatchCreateAssets_Handler,
+		},
+		{
+			MethodName: "BatchUpdateAssets",
+			Handler:    _AssetService_BatchUpdateAssets_Handler,
+		},
+		{
+			MethodName: "BatchDeleteAssets",
+			Handler:    _AssetService_BatchDeleteAssets_Handler,
+		},
+		{
+			MethodName: "UpsertAsset",
+			Handler:    _AssetService_UpsertAsset_Handler,
+		},
+		{
+			MethodName: "ListDeletedAssets",
+			Handler:    _AssetService_ListDeletedAssets_Handler,
+		},
+		{
+			MethodName: "RestoreAsset",
+			Handler:    _AssetService_RestoreAsset_Handler,
+		},
+		{
+			MethodName: "PurgeAsset",
+			Handler:    _AssetService_PurgeAsset_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AssetService_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/asset.proto",
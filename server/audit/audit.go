@@ -0,0 +1,215 @@
+// Package audit keeps an append-only log of every mutation made through the
+// asset RPCs. Events are only ever inserted; nothing in the server updates or
+// deletes them.
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"time"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/deadline"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/principal"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/requestid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// GatewayClientAddressKey is the metadata key carrying the address of the
+// HTTP client of a REST call. The REST gateway makes its calls over an
+// in-memory connection, so the peer address says nothing about the client.
+// The key is only trusted on calls made over that connection.
+const GatewayClientAddressKey = "x-gateway-client-address"
+
+// gatewayNetwork is the network of the in-memory connection of the REST
+// gateway.
+const gatewayNetwork = "bufconn"
+
+// Event is the stored form of an audit entry. Before and After hold the
+// asset document as it was before and after the mutation.
+type Event struct {
+	ID   primitive.ObjectID `bson:"_id,omitempty"`
+	Time time.Time          `bson:"time"`
+	// Owner is the owner of the asset, which is not necessarily the actor.
+	Owner      string   `bson:"owner"`
+	Actor      string   `bson:"actor"`
+	Method     string   `bson:"method"`
+	AssetID    string   `bson:"asset_id"`
+	Before     bson.Raw `bson:"before,omitempty"`
+	After      bson.Raw `bson:"after,omitempty"`
+	ClientAddr string   `bson:"client_address"`
+	RequestID  string   `bson:"request_id"`
+}
+
+// Recorder writes audit events to a collection.
+type Recorder struct {
+	events      *mongo.Collection
+	legacyOwner string
+}
+
+// NewRecorder records to events. Events recorded before assets had owners
+// carry no owner and are listed as events of legacyOwner, who was given
+// those assets, if it is set.
+func NewRecorder(events *mongo.Collection, legacyOwner string) *Recorder {
+	return &Recorder{events: events, legacyOwner: legacyOwner}
+}
+
+// Change is a mutation of the asset AssetID, owned by Owner. Before or After
+// may be nil for creations and removals.
+type Change struct {
+	Owner   string
+	AssetID string
+	Before  interface{}
+	After   interface{}
+}
+
+// Record appends an event for a mutation of assetID, owned by owner, made by
+// the RPC ctx belongs to. before or after may be nil for creations and
+// removals. Failures are logged rather than returned since the mutation has
+// already been applied by the time it is recorded.
+func (r *Recorder) Record(ctx context.Context, owner, assetID string, before, after interface{}) {
+	r.RecordAll(ctx, []Change{{Owner: owner, AssetID: assetID, Before: before, After: after}})
+}
+
+// RecordAll is like Record for many mutations made by one RPC, which are
+// recorded in a single insert.
+func (r *Recorder) RecordAll(ctx context.Context, changes []Change) {
+	event := Event{
+		Actor:     principal.Name(ctx),
+		RequestID: requestid.FromContext(ctx),
+	}
+	if method, ok := grpc.Method(ctx); ok {
+		event.Method = method
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		event.ClientAddr = p.Addr.String()
+		if p.Addr.Network() == gatewayNetwork {
+			event.ClientAddr = gatewayClientAddress(ctx)
+		}
+	}
+	r.insert(ctx, event, changes)
+}
+
+// gatewayClientAddress returns the client address the REST gateway passed
+// along with the call of ctx. The gateway adds it after any metadata taken
+// from the request, so the last value is its own.
+func gatewayClientAddress(ctx context.Context) string {
+	values := metadata.ValueFromIncomingContext(ctx, GatewayClientAddressKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1]
+}
+
+// RecordJob appends an event for a mutation made by a background job of the
+// server rather than by an RPC.
+func (r *Recorder) RecordJob(ctx context.Context, job, owner, assetID string, before, after interface{}) {
+	r.insert(ctx, Event{Actor: "system", Method: job}, []Change{{Owner: owner, AssetID: assetID, Before: before, After: after}})
+}
+
+// insert stores an event for each of changes, taking the fields not
+// describing the change from event.
+func (r *Recorder) insert(ctx context.Context, event Event, changes []Change) {
+	event.Time = time.Now()
+	events := make([]interface{}, 0, len(changes))
+	for _, c := range changes {
+		e := event
+		e.Owner = c.Owner
+		e.AssetID = c.AssetID
+		var err error
+		if e.Before, err = marshal(c.Before); err != nil {
+			slog.Error("Failed to record audit event", "asset", c.AssetID, "err", err)
+			continue
+		}
+		if e.After, err = marshal(c.After); err != nil {
+			slog.Error("Failed to record audit event", "asset", c.AssetID, "err", err)
+			continue
+		}
+		events = append(events, e)
+	}
+	if len(events) == 0 {
+		return
+	}
+	// Events are recorded after the mutation is done, so a canceled request
+	// context must not drop them.
+	ctx, cancel := deadline.Detached(ctx)
+	defer cancel()
+	if _, err := r.events.InsertMany(ctx, events, options.InsertMany().SetOrdered(false)); err != nil {
+		slog.Error("Failed to record audit events", "count", len(events), "err", err)
+	}
+}
+
+// Query selects audit events. Zero fields are not filtered on.
+type Query struct {
+	// Owners are the owners of the portfolios to list events of; nil lists
+	// the events of all portfolios.
+	Owners  []string
+	AssetID string
+	Start   time.Time
+	End     time.Time
+	// Before only returns events with an id lower than it, for paging.
+	Before primitive.ObjectID
+	Limit  int64
+}
+
+// List returns the events matching q, newest first.
+func (r *Recorder) List(ctx context.Context, q Query) ([]Event, error) {
+	filter := bson.M{}
+	if q.Owners != nil {
+		owners := bson.A{}
+		for _, owner := range q.Owners {
+			owners = append(owners, owner)
+			if r.legacyOwner != "" && owner == r.legacyOwner {
+				owners = append(owners, "", nil)
+			}
+		}
+		filter["owner"] = bson.M{"$in": owners}
+	}
+	if q.AssetID != "" {
+		filter["asset_id"] = q.AssetID
+	}
+	timeRange := bson.M{}
+	if !q.Start.IsZero() {
+		timeRange["$gte"] = q.Start
+	}
+	if !q.End.IsZero() {
+		timeRange["$lt"] = q.End
+	}
+	if len(timeRange) > 0 {
+		filter["time"] = timeRange
+	}
+	if !q.Before.IsZero() {
+		filter["_id"] = bson.M{"$lt": q.Before}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}).SetLimit(q.Limit)
+	cursor, err := r.events.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var events []Event
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	for i := range events {
+		if events[i].Owner == "" {
+			events[i].Owner = r.legacyOwner
+		}
+	}
+	return events, nil
+}
+
+func marshal(doc interface{}) (bson.Raw, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	if v := reflect.ValueOf(doc); v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, nil
+	}
+	return bson.Marshal(doc)
+}
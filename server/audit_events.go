@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/audit"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/principal"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/rbac"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/sharing"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultAuditPageSize = 100
+
+// ListAuditEvents lists the audit events of the portfolios the caller may
+// audit, newest first.
+func (s *server) ListAuditEvents(ctx context.Context, req *asset.ListAuditEventsRequest) (*asset.ListAuditEventsResponse, error) {
+	owners, err := auditOwners(ctx, req.Owner)
+	if err != nil {
+		return nil, err
+	}
+	q := audit.Query{
+		Owners:  owners,
+		AssetID: req.AssetId,
+		Limit:   int64(req.PageSize),
+	}
+	if q.Limit == 0 {
+		q.Limit = defaultAuditPageSize
+	}
+	if req.AssetId != "" && !primitive.IsValidObjectID(req.AssetId) {
+		return nil, grpcerr.InvalidID("asset_id", req.AssetId)
+	}
+	if req.StartTime != nil {
+		q.Start = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		q.End = req.EndTime.AsTime()
+	}
+	if req.PageToken != "" {
+		before, err := primitive.ObjectIDFromHex(req.PageToken)
+		if err != nil {
+			return nil, grpcerr.InvalidArgument("page_token", "invalid page token")
+		}
+		q.Before = before
+	}
+
+	events, err := s.audit.List(ctx, q)
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "audit event", "")
+	}
+	resp := &asset.ListAuditEventsResponse{}
+	for _, e := range events {
+		event := &asset.AuditEvent{
+			Id:            e.ID.Hex(),
+			Time:          timestamppb.New(e.Time),
+			Actor:         e.Actor,
+			Method:        e.Method,
+			AssetId:       e.AssetID,
+			Owner:         e.Owner,
+			ClientAddress: e.ClientAddr,
+			RequestId:     e.RequestID,
+		}
+		if event.Before, err = decodeAuditAsset(e.Before); err != nil {
+			return nil, grpcerr.FromMongo(err, "audit event", e.ID.Hex())
+		}
+		if event.After, err = decodeAuditAsset(e.After); err != nil {
+			return nil, grpcerr.FromMongo(err, "audit event", e.ID.Hex())
+		}
+		resp.Events = append(resp.Events, event)
+	}
+	if int64(len(events)) == q.Limit {
+		resp.NextPageToken = events[len(events)-1].ID.Hex()
+	}
+	return resp, nil
+}
+
+// auditOwners returns the owners whose audit events the caller of ctx lists
+// for the requested owner: their own portfolio and those shared with them
+// as editor, or all portfolios, as nil, for admins. owner narrows this down
+// to one portfolio the caller may audit.
+func auditOwners(ctx context.Context, owner string) ([]string, error) {
+	p, ok := principal.FromContext(ctx)
+	all := ok && rbac.Granted(p, rbac.ReadAllAudit)
+	if owner != "" {
+		if !all && !sharing.Allowed(ctx, owner, rbac.ReadAudit) {
+			return nil, status.Errorf(codes.PermissionDenied, "audit events of the portfolio of %q are not shared with you", owner)
+		}
+		return []string{owner}, nil
+	}
+	if all {
+		return nil, nil
+	}
+	return sharing.Owners(ctx, rbac.ReadAudit), nil
+}
+
+func decodeAuditAsset(raw bson.Raw) (*asset.Asset, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	var doc assetDocument
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return doc.toProto(), nil
+}
@@ -0,0 +1,230 @@
+// Package auth authenticates RPCs by verifying the JWT bearer token sent in
+// the authorization metadata of every call, or alternatively an API key or a
+// TLS client certificate, and stores the caller in the handler context for
+// the principal package.
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/principal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// MetadataKey is the metadata key carrying the bearer token.
+	MetadataKey = "authorization"
+	// APIKeyMetadataKey is the metadata key carrying an API key, which is
+	// accepted instead of a bearer token.
+	APIKeyMetadataKey = "x-api-key"
+)
+
+// ErrInvalidAPIKey is returned by APIKeys for keys that are not valid.
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// clockSkew is the leeway allowed when checking token times.
+const clockSkew = 30 * time.Second
+
+// Config selects the keys tokens may be signed with. At least one of
+// HMACSecret and JWKSFile must be set.
+type Config struct {
+	// HMACSecret verifies HS256 tokens.
+	HMACSecret []byte
+	// JWKSFile is a local JWK set whose RSA keys verify RS256 tokens.
+	JWKSFile string
+	// Issuer and Audience, if set, must match the iss and aud claims.
+	Issuer   string
+	Audience string
+}
+
+// claims are the claims read from and written to tokens. Roles is a
+// non-standard claim listing the rbac roles of the subject.
+type claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles,omitempty"`
+}
+
+// Verifier checks bearer tokens.
+type Verifier struct {
+	hmacSecret []byte
+	rsaKeys    map[string]*rsa.PublicKey
+	parser     *jwt.Parser
+}
+
+// NewVerifier loads the keys of cfg.
+func NewVerifier(cfg Config) (*Verifier, error) {
+	if len(cfg.HMACSecret) == 0 && cfg.JWKSFile == "" {
+		return nil, errors.New("no token verification keys configured")
+	}
+	v := &Verifier{hmacSecret: cfg.HMACSecret}
+	if cfg.JWKSFile != "" {
+		keys, err := LoadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		v.rsaKeys = keys
+	}
+	var methods []string
+	if len(v.hmacSecret) > 0 {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if len(v.rsaKeys) > 0 {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(clockSkew),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	v.parser = jwt.NewParser(opts...)
+	return v, nil
+}
+
+// Verify checks the signature and claims of token and returns the caller it
+// was issued to.
+func (v *Verifier) Verify(token string) (*principal.Principal, error) {
+	var c claims
+	if _, err := v.parser.ParseWithClaims(token, &c, v.key); err != nil {
+		return nil, err
+	}
+	if c.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+	return &principal.Principal{Subject: c.Subject, Roles: c.Roles}, nil
+}
+
+func (v *Verifier) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		return v.hmacSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := v.rsaKeys[kid]; ok {
+			return key, nil
+		}
+		// Tokens without a key id are accepted when the set has one key.
+		if kid == "" && len(v.rsaKeys) == 1 {
+			for _, key := range v.rsaKeys {
+				return key, nil
+			}
+		}
+		return nil, errors.New("unknown signing key")
+	}
+	return nil, errors.New("unexpected signing method")
+}
+
+// APIKeys looks up the callers API keys belong to.
+type APIKeys interface {
+	// Principal returns the caller key authenticates. It returns
+	// ErrInvalidAPIKey for keys that are not valid.
+	Principal(ctx context.Context, key string) (*principal.Principal, error)
+}
+
+// Authenticator identifies the callers of RPCs. An API key takes precedence
+// over a bearer token, and a bearer token over a client certificate.
+type Authenticator struct {
+	// Tokens verifies bearer tokens.
+	Tokens *Verifier
+	// APIKeys, if not nil, looks up API keys.
+	APIKeys APIKeys
+	// Certificates, if not nil, authenticates callers by the verified TLS
+	// client certificate of their connection.
+	Certificates Certificates
+}
+
+// authenticate returns ctx with the caller of the RPC, or an Unauthenticated
+// error.
+func (a *Authenticator) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(APIKeyMetadataKey); len(values) > 0 && a.APIKeys != nil {
+		p, err := a.APIKeys.Principal(ctx, values[0])
+		if errors.Is(err, ErrInvalidAPIKey) {
+			return nil, grpcerr.Unauthenticated("invalid API key")
+		}
+		if err != nil {
+			return nil, err
+		}
+		return principal.NewContext(ctx, p), nil
+	}
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		if p, ok := a.Certificates.principal(ctx); ok {
+			return principal.NewContext(ctx, p), nil
+		}
+		return nil, grpcerr.Unauthenticated("missing bearer token")
+	}
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return nil, grpcerr.Unauthenticated("authorization metadata must be a bearer token")
+	}
+	p, err := a.Tokens.Verify(token)
+	if err != nil {
+		return nil, grpcerr.Unauthenticated("invalid bearer token: " + err.Error())
+	}
+	return principal.NewContext(ctx, p), nil
+}
+
+// UnaryServerInterceptor rejects calls without a valid bearer token, API key
+// or client certificate and stores the caller in the handler context. Calls
+// to the public methods, given by full method name, are let through
+// unauthenticated.
+func UnaryServerInterceptor(a *Authenticator, public ...string) grpc.UnaryServerInterceptor {
+	skip := methodSet(public)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if skip[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		ctx, err := a.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor(a *Authenticator, public ...string) grpc.StreamServerInterceptor {
+	skip := methodSet(public)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if skip[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		ctx, err := a.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func methodSet(methods []string) map[string]bool {
+	set := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		set[m] = true
+	}
+	return set
+}
+
+// serverStream overrides the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
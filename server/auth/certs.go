@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/principal"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// Certificates maps the subject distinguished names of client certificates,
+// in the form of pkix.Name.String such as "CN=importer,O=Example", to the
+// callers they authenticate.
+type Certificates map[string]*principal.Principal
+
+// LoadCertificates reads a JSON file mapping subject names to callers:
+//
+//	{"CN=importer,O=Example": {"subject": "importer", "roles": ["editor"]}}
+func LoadCertificates(path string) (Certificates, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entries map[string]struct {
+		Subject string   `json:"subject"`
+		Roles   []string `json:"roles"`
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parse client certificates %s: %w", path, err)
+	}
+	certs := make(Certificates, len(entries))
+	for name, e := range entries {
+		if e.Subject == "" {
+			return nil, fmt.Errorf("client certificate %q: missing subject", name)
+		}
+		certs[name] = &principal.Principal{Subject: e.Subject, Roles: e.Roles}
+	}
+	return certs, nil
+}
+
+// principal returns the caller the verified client certificate of the RPC
+// in ctx authenticates, if any.
+func (c Certificates) principal(ctx context.Context) (*principal.Principal, bool) {
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	info, ok := pr.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+	p, ok := c[info.State.VerifiedChains[0][0].Subject.String()]
+	return p, ok
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Issuer signs HS256 access tokens that a Verifier configured with the same
+// secret, issuer and audience accepts.
+type Issuer struct {
+	secret   []byte
+	issuer   string
+	audience string
+	ttl      time.Duration
+}
+
+// NewIssuer returns an Issuer for tokens valid for ttl. cfg must have an
+// HMACSecret.
+func NewIssuer(cfg Config, ttl time.Duration) (*Issuer, error) {
+	if len(cfg.HMACSecret) == 0 {
+		return nil, errors.New("issuing tokens requires an HMAC secret")
+	}
+	return &Issuer{secret: cfg.HMACSecret, issuer: cfg.Issuer, audience: cfg.Audience, ttl: ttl}, nil
+}
+
+// TTL returns how long issued tokens are valid.
+func (i *Issuer) TTL() time.Duration {
+	return i.ttl
+}
+
+// Issue returns a signed access token for subject holding roles.
+func (i *Issuer) Issue(subject string, roles []string, now time.Time) (string, error) {
+	c := claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			Issuer:    i.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(i.ttl)),
+		},
+		Roles: roles,
+	}
+	if i.audience != "" {
+		c.Audience = jwt.ClaimStrings{i.audience}
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(i.secret)
+}
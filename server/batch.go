@@ -0,0 +1,372 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/validate"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// batchItem tracks a single entry of a batch request while it is written
+// and reported back to the caller.
+type batchItem struct {
+	index int
+	id    primitive.ObjectID
+	// created is the inserted document for creates. Updates and deletes set
+	// the version they were made against, filter and update instead, and
+	// apply, which derives the written document from the one the update
+	// matched.
+	created *assetDocument
+	version int64
+	filter  bson.M
+	update  bson.M
+	apply   func(d *assetDocument)
+	// before and after are filled in once the item has been written, err
+	// once it has failed.
+	before *assetDocument
+	after  *assetDocument
+	err    error
+}
+
+func (s *server) BatchCreateAssets(ctx context.Context, req *asset.BatchCreateAssetsRequest) (*asset.BatchAssetsResponse, error) {
+	now := writeTime()
+	results := make([]*asset.BatchItemResult, len(req.Requests))
+	var items []*batchItem
+	for i, r := range req.Requests {
+		if err := validate.Request(r, fmt.Sprintf("requests[%d]", i)); err != nil {
+			if err := rejectItem(req.Atomic, results, i, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		owner, err := targetOwner(ctx, r.Owner)
+		if err != nil {
+			if err := rejectItem(req.Atomic, results, i, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		doc := newAssetDocument(ctx, owner, now, r.Symbol, r.Quantity, r.Price)
+		items = append(items, &batchItem{index: i, id: doc.ID, created: doc})
+	}
+	if err := s.runBatch(ctx, req.Atomic, items, results); err != nil {
+		return nil, err
+	}
+	return &asset.BatchAssetsResponse{Results: results}, nil
+}
+
+func (s *server) BatchUpdateAssets(ctx context.Context, req *asset.BatchUpdateAssetsRequest) (*asset.BatchAssetsResponse, error) {
+	now := writeTime()
+	results := make([]*asset.BatchItemResult, len(req.Requests))
+	var items []*batchItem
+	seen := make(map[primitive.ObjectID]bool)
+	for i, r := range req.Requests {
+		objID, err := batchItemID(r, i, r.Id, seen)
+		if err != nil {
+			if err := rejectItem(req.Atomic, results, i, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		items = append(items, &batchItem{
+			index:   i,
+			id:      objID,
+			version: r.Version,
+			filter:  versionFilter(ctx, objID, r.Version),
+			update: stampUpdate(ctx, now, bson.M{
+				"symbol":   r.Symbol,
+				"quantity": r.Quantity,
+				"price":    r.Price,
+			}),
+			apply: func(d *assetDocument) {
+				d.Symbol = r.Symbol
+				d.Quantity = r.Quantity
+				d.Price = r.Price
+				d.touch(ctx, now)
+			},
+		})
+	}
+	if err := s.runBatch(ctx, req.Atomic, items, results); err != nil {
+		return nil, err
+	}
+	return &asset.BatchAssetsResponse{Results: results}, nil
+}
+
+// BatchDeleteAssets moves the requested assets to the trash, like
+// DeleteAsset.
+func (s *server) BatchDeleteAssets(ctx context.Context, req *asset.BatchDeleteAssetsRequest) (*asset.BatchAssetsResponse, error) {
+	now := writeTime()
+	results := make([]*asset.BatchItemResult, len(req.Requests))
+	var items []*batchItem
+	seen := make(map[primitive.ObjectID]bool)
+	for i, r := range req.Requests {
+		objID, err := batchItemID(r, i, r.Id, seen)
+		if err != nil {
+			if err := rejectItem(req.Atomic, results, i, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		items = append(items, &batchItem{
+			index:   i,
+			id:      objID,
+			version: r.Version,
+			filter:  versionFilter(ctx, objID, r.Version),
+			update:  trashUpdate(ctx, now),
+			apply: func(d *assetDocument) {
+				d.DeletedAt = &now
+				d.touch(ctx, now)
+			},
+		})
+	}
+	if err := s.runBatch(ctx, req.Atomic, items, results); err != nil {
+		return nil, err
+	}
+	// Deleted assets are not echoed back.
+	for _, result := range results {
+		result.Asset = nil
+	}
+	return &asset.BatchAssetsResponse{Results: results}, nil
+}
+
+// batchItemID validates r, the request of item index naming the asset id,
+// and returns the id. It rejects an asset named twice in the batch, since
+// the second write of the asset could not match the version the request was
+// made against.
+func batchItemID(r proto.Message, index int, id string, seen map[primitive.ObjectID]bool) (primitive.ObjectID, error) {
+	if err := validate.Request(r, fmt.Sprintf("requests[%d]", index)); err != nil {
+		return primitive.NilObjectID, err
+	}
+	// The id has been validated.
+	objID, _ := primitive.ObjectIDFromHex(id)
+	if seen[objID] {
+		return primitive.NilObjectID, grpcerr.InvalidArgument(fmt.Sprintf("requests[%d].id", index), fmt.Sprintf("asset %q appears more than once in the batch", id))
+	}
+	seen[objID] = true
+	return objID, nil
+}
+
+// rejectItem reports the failure of item index before anything is written:
+// in atomic mode it fails the whole batch by returning err, otherwise it
+// fails the item alone.
+func rejectItem(atomic bool, results []*asset.BatchItemResult, index int, err error) error {
+	if atomic {
+		return err
+	}
+	results[index] = batchItemError(index, err)
+	return nil
+}
+
+// runBatch writes items with one bulk write and fills in results for each
+// of them. In atomic mode the bulk write is ordered and runs inside a
+// transaction, and the first failing item aborts the whole batch; otherwise
+// it is unordered and failures are reported per item. The written items are
+// recorded in the audit log and the history together.
+func (s *server) runBatch(ctx context.Context, atomic bool, items []*batchItem, results []*asset.BatchItemResult) error {
+	if len(items) == 0 {
+		return nil
+	}
+	if atomic {
+		session, err := s.mongoClient.StartSession()
+		if err != nil {
+			return grpcerr.FromMongo(err, "asset", "")
+		}
+		defer session.EndSession(ctx)
+		_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+			for _, item := range items {
+				item.before, item.after, item.err = nil, nil, nil
+			}
+			return nil, s.writeBatch(sc, items, true)
+		})
+		if err != nil {
+			return grpcerr.FromMongo(err, "asset", "")
+		}
+	} else if err := s.writeBatch(ctx, items, false); err != nil {
+		return grpcerr.FromMongo(err, "asset", "")
+	}
+
+	var changes []assetChange
+	for _, item := range items {
+		if item.err != nil {
+			results[item.index] = batchItemError(item.index, item.err)
+			continue
+		}
+		changes = append(changes, assetChange{before: item.before, after: item.after})
+		results[item.index] = &asset.BatchItemResult{Index: int32(item.index), Code: int32(codes.OK), Asset: item.after.toProto()}
+	}
+	if len(changes) > 0 {
+		s.recordChanges(ctx, changes)
+	}
+	return nil
+}
+
+// writeBatch writes items with one bulk write, setting err on every item
+// that fails and before and after on the others. Updates and deletes are
+// checked against the stored documents first, so that only items that can
+// apply are written and each written item matches the document read for
+// it. An ordered write stops at, and returns, the error of the first failing
+// item; other errors concern the whole batch.
+func (s *server) writeBatch(ctx context.Context, items []*batchItem, ordered bool) error {
+	assetCollection := s.assets
+	current, err := currentDocuments(ctx, assetCollection, items)
+	if err != nil {
+		return err
+	}
+	var models []mongo.WriteModel
+	var written []*batchItem
+	updates := 0
+	for _, item := range items {
+		if item.created != nil {
+			item.after = item.created
+			models = append(models, mongo.NewInsertOneModel().SetDocument(item.created))
+		} else if item.err = prepareItem(item, current); item.err != nil {
+			if ordered {
+				return item.err
+			}
+			continue
+		} else {
+			models = append(models, mongo.NewUpdateOneModel().SetFilter(item.filter).SetUpdate(item.update))
+			updates++
+		}
+		written = append(written, item)
+	}
+	if len(models) == 0 {
+		return nil
+	}
+
+	res, err := assetCollection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(ordered))
+	var bulkErr mongo.BulkWriteException
+	if err != nil && !errors.As(err, &bulkErr) {
+		return err
+	}
+	for _, writeErr := range bulkErr.WriteErrors {
+		item := written[writeErr.Index]
+		key := item.id.Hex()
+		if item.created != nil {
+			key = item.created.Symbol
+		}
+		item.err = grpcerr.FromMongo(mongo.WriteException{WriteErrors: mongo.WriteErrors{writeErr.WriteError}}, "asset", key)
+		if item.created == nil {
+			updates--
+		}
+		if ordered {
+			return item.err
+		}
+	}
+	if bulkErr.WriteConcernError != nil {
+		return err
+	}
+	if res != nil && res.MatchedCount < int64(updates) {
+		// Some asset changed between the read and the write.
+		if err := s.findLostUpdates(ctx, written); err != nil {
+			return err
+		}
+		if ordered {
+			for _, item := range written {
+				if item.err != nil {
+					return item.err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// findLostUpdates sets err on the written updates and deletes that matched
+// no document. The bulk write only counts the matches, so an update is taken
+// to have applied if the asset is now at the version, update time and writer
+// the update gave it.
+func (s *server) findLostUpdates(ctx context.Context, written []*batchItem) error {
+	var ids []primitive.ObjectID
+	for _, item := range written {
+		if item.created == nil && item.err == nil {
+			ids = append(ids, item.id)
+		}
+	}
+	cursor, err := s.assets.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return err
+	}
+	var docs []assetDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return err
+	}
+	stored := make(map[primitive.ObjectID]*assetDocument, len(docs))
+	for i := range docs {
+		stored[docs[i].ID] = &docs[i]
+	}
+	for _, item := range written {
+		if item.created != nil || item.err != nil {
+			continue
+		}
+		doc, ok := stored[item.id]
+		if ok && doc.Version == item.after.Version && doc.UpdatedAt.Equal(item.after.UpdatedAt) && doc.UpdatedBy == item.after.UpdatedBy {
+			continue
+		}
+		item.err = s.writeConflict(ctx, s.assets, item.filter)
+	}
+	return nil
+}
+
+// prepareItem checks that the update or delete item can apply to the
+// documents read by currentDocuments and fills in its before and after
+// documents.
+func prepareItem(item *batchItem, current map[primitive.ObjectID]*assetDocument) error {
+	before, ok := current[item.id]
+	if !ok {
+		return grpcerr.NotFound("asset", item.id.Hex())
+	}
+	if before.Version != item.version {
+		return grpcerr.VersionMismatch("asset", item.id.Hex(), before.Version)
+	}
+	after := *before
+	item.apply(&after)
+	item.before, item.after = before, &after
+	return nil
+}
+
+// currentDocuments returns the stored document of every update or delete
+// item that is currently a live asset the caller may change.
+func currentDocuments(ctx context.Context, assetCollection *mongo.Collection, items []*batchItem) (map[primitive.ObjectID]*assetDocument, error) {
+	var ids []primitive.ObjectID
+	for _, item := range items {
+		if item.created == nil {
+			ids = append(ids, item.id)
+		}
+	}
+	current := make(map[primitive.ObjectID]*assetDocument)
+	if len(ids) == 0 {
+		return current, nil
+	}
+	cursor, err := assetCollection.Find(ctx, writable(ctx, bson.M{"_id": bson.M{"$in": ids}, "deleted_at": nil}))
+	if err != nil {
+		return nil, err
+	}
+	var docs []assetDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	for i := range docs {
+		current[docs[i].ID] = &docs[i]
+	}
+	return current, nil
+}
+
+func batchItemError(index int, err error) *asset.BatchItemResult {
+	st := status.Convert(err)
+	return &asset.BatchItemResult{
+		Index:   int32(index),
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}
+}
@@ -0,0 +1,528 @@
+// Package config loads the server configuration. Every setting has a
+// default, which a YAML file, then an environment variable and finally a
+// command-line flag may override.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionalphapb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"gopkg.in/yaml.v3"
+)
+
+// minHMACSecretLen is the shortest HS256 secret accepted, the length of the
+// hash, as RFC 7518 requires.
+const minHMACSecretLen = 32
+
+// services are the services the server offers, whose methods may have
+// deadlines of their own.
+var services = []grpc.ServiceDesc{
+	asset.AssetService_ServiceDesc,
+	asset.AuthService_ServiceDesc,
+	asset.SharingService_ServiceDesc,
+	asset.ApiKeyService_ServiceDesc,
+	healthpb.Health_ServiceDesc,
+	reflectionpb.ServerReflection_ServiceDesc,
+	reflectionalphapb.ServerReflection_ServiceDesc,
+}
+
+// isMethod reports whether name is the full name of a method of services.
+func isMethod(name string) bool {
+	for _, desc := range services {
+		for _, m := range desc.Methods {
+			if name == "/"+desc.ServiceName+"/"+m.MethodName {
+				return true
+			}
+		}
+		for _, st := range desc.Streams {
+			if name == "/"+desc.ServiceName+"/"+st.StreamName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Config is the complete server configuration.
+type Config struct {
+	// Listen is the address the server listens on for native gRPC
+	// clients.
+	Listen string `yaml:"listen"`
+	// HTTPListen is the address the server listens on for gRPC-Web, REST
+	// and the web frontend.
+	HTTPListen string `yaml:"http_listen"`
+	// ShutdownTimeout bounds how long the server waits for running requests
+	// when asked to stop.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+
+	Mongo     MongoConfig     `yaml:"mongo"`
+	Deadlines DeadlinesConfig `yaml:"deadlines"`
+	Auth      AuthConfig      `yaml:"auth"`
+	TLS       TLSConfig       `yaml:"tls"`
+	CORS      CORSConfig      `yaml:"cors"`
+	Trash     TrashConfig     `yaml:"trash"`
+	Health    HealthConfig    `yaml:"health"`
+	Metrics   MetricsConfig   `yaml:"metrics"`
+	Log       LogConfig       `yaml:"log"`
+}
+
+type MongoConfig struct {
+	URI      string `yaml:"uri"`
+	Database string `yaml:"database"`
+	// StartupTimeout bounds how long the server retries reaching the
+	// database before giving up on starting.
+	StartupTimeout time.Duration `yaml:"startup_timeout"`
+	// The connection settings below override the same options of URI.
+	// Zero values leave them to the URI, or to the defaults of package
+	// mongodb where the URI does not set them either.
+	ConnectTimeout         time.Duration `yaml:"connect_timeout"`
+	ServerSelectionTimeout time.Duration `yaml:"server_selection_timeout"`
+	// SocketTimeout bounds socket reads and writes.
+	SocketTimeout time.Duration `yaml:"socket_timeout"`
+	MinPoolSize   uint64        `yaml:"min_pool_size"`
+	// MaxPoolSize limits the connections per server.
+	MaxPoolSize uint64 `yaml:"max_pool_size"`
+	// ReadConcern is local, available, majority or linearizable.
+	ReadConcern string `yaml:"read_concern"`
+	// WriteConcern is majority or the number of members acknowledging
+	// writes.
+	WriteConcern string      `yaml:"write_concern"`
+	Collections  Collections `yaml:"collections"`
+	// LegacyOwner, if set, is given the assets created before assets had
+	// owners.
+	LegacyOwner string `yaml:"legacy_owner"`
+}
+
+// Collections names the collections of the database.
+type Collections struct {
+	Assets        string `yaml:"assets"`
+	Revisions     string `yaml:"revisions"`
+	AuditEvents   string `yaml:"audit_events"`
+	Users         string `yaml:"users"`
+	RefreshTokens string `yaml:"refresh_tokens"`
+	Shares        string `yaml:"shares"`
+	APIKeys       string `yaml:"api_keys"`
+}
+
+type DeadlinesConfig struct {
+	// Default is the deadline of calls whose client sets none and Max caps
+	// the deadline clients may set.
+	Default time.Duration `yaml:"default"`
+	Max     time.Duration `yaml:"max"`
+	// Methods overrides Default and Max for single methods, keyed by full
+	// method name such as /assets.AssetService/ListAssets. Zero fields
+	// keep the general limit.
+	Methods map[string]MethodDeadlines `yaml:"methods"`
+}
+
+type MethodDeadlines struct {
+	Default time.Duration `yaml:"default"`
+	Max     time.Duration `yaml:"max"`
+}
+
+type AuthConfig struct {
+	// HMACSecret verifies HS256 tokens and lets the server issue its own.
+	HMACSecret string `yaml:"hmac_secret"`
+	// JWKSFile is a JWK set whose RSA keys verify RS256 tokens.
+	JWKSFile        string        `yaml:"jwks_file"`
+	Issuer          string        `yaml:"issuer"`
+	Audience        string        `yaml:"audience"`
+	AccessTokenTTL  time.Duration `yaml:"access_token_ttl"`
+	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl"`
+	// AllowRegistration lets anyone create an account with
+	// AuthService.Register. Closed deployments turn it off and create
+	// accounts in the database.
+	AllowRegistration bool `yaml:"allow_registration"`
+}
+
+type TLSConfig struct {
+	CertFile          string `yaml:"cert_file"`
+	KeyFile           string `yaml:"key_file"`
+	ClientCAFile      string `yaml:"client_ca_file"`
+	RequireClientCert bool   `yaml:"require_client_cert"`
+	// ClientCertsFile maps client certificate subjects to callers.
+	ClientCertsFile string        `yaml:"client_certs_file"`
+	ReloadInterval  time.Duration `yaml:"reload_interval"`
+}
+
+type CORSConfig struct {
+	// AllowedOrigins are the origins of the web pages allowed to call the
+	// server with gRPC-Web. "*" allows any origin.
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
+type TrashConfig struct {
+	// Trashed assets are purged once they have been deleted for longer than
+	// Retention. The purge job checks every PurgeInterval.
+	Retention     time.Duration `yaml:"retention"`
+	PurgeInterval time.Duration `yaml:"purge_interval"`
+}
+
+type HealthConfig struct {
+	// The database is pinged every CheckInterval and reported unreachable
+	// if it does not answer within CheckTimeout.
+	CheckInterval time.Duration `yaml:"check_interval"`
+	CheckTimeout  time.Duration `yaml:"check_timeout"`
+}
+
+type MetricsConfig struct {
+	// Listen is the address /metrics is served on in plaintext, apart from
+	// the API. Empty disables the endpoint.
+	Listen string `yaml:"listen"`
+	// PortfolioInterval is how often the number and value of the assets in
+	// all portfolios are computed.
+	PortfolioInterval time.Duration `yaml:"portfolio_interval"`
+}
+
+type LogConfig struct {
+	// Level is one of debug, info, warn and error.
+	Level string `yaml:"level"`
+	// Format is text or json.
+	Format string `yaml:"format"`
+}
+
+// Default returns the configuration used when nothing is overridden.
+func Default() *Config {
+	return &Config{
+		Listen:          ":50051",
+		HTTPListen:      ":8081",
+		ShutdownTimeout: 30 * time.Second,
+		Mongo: MongoConfig{
+			URI:            "mongodb://localhost:27017",
+			Database:       "assetdb",
+			StartupTimeout: time.Minute,
+			Collections: Collections{
+				Assets:        "assets",
+				Revisions:     "asset_revisions",
+				AuditEvents:   "audit_events",
+				Users:         "users",
+				RefreshTokens: "refresh_tokens",
+				Shares:        "portfolio_shares",
+				APIKeys:       "api_keys",
+			},
+		},
+		Deadlines: DeadlinesConfig{
+			Default: 30 * time.Second,
+			Max:     2 * time.Minute,
+		},
+		Auth: AuthConfig{
+			AccessTokenTTL:    15 * time.Minute,
+			RefreshTokenTTL:   30 * 24 * time.Hour,
+			AllowRegistration: true,
+		},
+		TLS: TLSConfig{
+			ReloadInterval: 10 * time.Second,
+		},
+		CORS: CORSConfig{
+			// The development server of the frontend.
+			AllowedOrigins: []string{"http://localhost:8080"},
+		},
+		Trash: TrashConfig{
+			Retention:     30 * 24 * time.Hour,
+			PurgeInterval: time.Hour,
+		},
+		Health: HealthConfig{
+			CheckInterval: 10 * time.Second,
+			CheckTimeout:  2 * time.Second,
+		},
+		Metrics: MetricsConfig{
+			Listen:            "127.0.0.1:9090",
+			PortfolioInterval: time.Minute,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "text",
+		},
+	}
+}
+
+// setting binds a flag and an environment variable to a field.
+type setting struct {
+	flag, env, usage string
+	field            interface{}
+}
+
+func (c *Config) settings() []setting {
+	return []setting{
+		{"listen", "LISTEN_ADDR", "address to listen on for native gRPC", &c.Listen},
+		{"http-listen", "HTTP_LISTEN_ADDR", "address to listen on for gRPC-Web, REST and the frontend", &c.HTTPListen},
+		{"shutdown-timeout", "SHUTDOWN_TIMEOUT", "how long to wait for running requests when stopping", &c.ShutdownTimeout},
+		{"mongo-uri", "MONGO_URI", "MongoDB connection string", &c.Mongo.URI},
+		{"mongo-database", "MONGO_DATABASE", "MongoDB database", &c.Mongo.Database},
+		{"mongo-startup-timeout", "MONGO_STARTUP_TIMEOUT", "how long to retry reaching MongoDB at startup", &c.Mongo.StartupTimeout},
+		{"mongo-connect-timeout", "MONGO_CONNECT_TIMEOUT", "timeout for connecting to MongoDB, 0 to keep the URI or default", &c.Mongo.ConnectTimeout},
+		{"mongo-server-selection-timeout", "MONGO_SERVER_SELECTION_TIMEOUT", "timeout for finding a MongoDB server for an operation, 0 to keep the URI or default", &c.Mongo.ServerSelectionTimeout},
+		{"mongo-socket-timeout", "MONGO_SOCKET_TIMEOUT", "timeout of MongoDB socket reads and writes, 0 to keep the URI or default", &c.Mongo.SocketTimeout},
+		{"mongo-min-pool-size", "MONGO_MIN_POOL_SIZE", "connections to keep open per MongoDB server, 0 to keep the URI or default", &c.Mongo.MinPoolSize},
+		{"mongo-max-pool-size", "MONGO_MAX_POOL_SIZE", "maximum connections per MongoDB server, 0 to keep the URI or default", &c.Mongo.MaxPoolSize},
+		{"mongo-read-concern", "MONGO_READ_CONCERN", "local, available, majority or linearizable, empty to keep the URI or default", &c.Mongo.ReadConcern},
+		{"mongo-write-concern", "MONGO_WRITE_CONCERN", "majority or the number of members acknowledging writes, empty to keep the URI or default", &c.Mongo.WriteConcern},
+		{"mongo-assets-collection", "MONGO_ASSETS_COLLECTION", "collection of assets", &c.Mongo.Collections.Assets},
+		{"mongo-revisions-collection", "MONGO_REVISIONS_COLLECTION", "collection of asset revisions", &c.Mongo.Collections.Revisions},
+		{"mongo-audit-collection", "MONGO_AUDIT_COLLECTION", "collection of audit events", &c.Mongo.Collections.AuditEvents},
+		{"mongo-users-collection", "MONGO_USERS_COLLECTION", "collection of users", &c.Mongo.Collections.Users},
+		{"mongo-refresh-tokens-collection", "MONGO_REFRESH_TOKENS_COLLECTION", "collection of refresh tokens", &c.Mongo.Collections.RefreshTokens},
+		{"mongo-shares-collection", "MONGO_SHARES_COLLECTION", "collection of portfolio shares", &c.Mongo.Collections.Shares},
+		{"mongo-api-keys-collection", "MONGO_API_KEYS_COLLECTION", "collection of API keys", &c.Mongo.Collections.APIKeys},
+		{"legacy-asset-owner", "LEGACY_ASSET_OWNER", "user id to give assets without an owner", &c.Mongo.LegacyOwner},
+		{"deadline-default", "DEADLINE_DEFAULT", "deadline of calls that set none", &c.Deadlines.Default},
+		{"deadline-max", "DEADLINE_MAX", "longest deadline calls may set", &c.Deadlines.Max},
+		{"jwt-hs256-secret", "JWT_HS256_SECRET", "secret verifying and signing HS256 tokens", &c.Auth.HMACSecret},
+		{"jwt-jwks-file", "JWT_JWKS_FILE", "JWK set file verifying RS256 tokens", &c.Auth.JWKSFile},
+		{"jwt-issuer", "JWT_ISSUER", "required iss claim of tokens", &c.Auth.Issuer},
+		{"jwt-audience", "JWT_AUDIENCE", "required aud claim of tokens", &c.Auth.Audience},
+		{"access-token-ttl", "ACCESS_TOKEN_TTL", "lifetime of issued access tokens", &c.Auth.AccessTokenTTL},
+		{"refresh-token-ttl", "REFRESH_TOKEN_TTL", "lifetime of issued refresh tokens", &c.Auth.RefreshTokenTTL},
+		{"allow-registration", "AUTH_ALLOW_REGISTRATION", "let anyone create an account", &c.Auth.AllowRegistration},
+		{"tls-cert-file", "TLS_CERT_FILE", "PEM certificate to serve TLS with", &c.TLS.CertFile},
+		{"tls-key-file", "TLS_KEY_FILE", "PEM key of the TLS certificate", &c.TLS.KeyFile},
+		{"tls-client-ca-file", "TLS_CLIENT_CA_FILE", "PEM CAs verifying client certificates", &c.TLS.ClientCAFile},
+		{"tls-require-client-cert", "TLS_REQUIRE_CLIENT_CERT", "reject clients without a certificate", &c.TLS.RequireClientCert},
+		{"tls-client-certs-file", "TLS_CLIENT_CERTS_FILE", "JSON file mapping client certificate subjects to callers", &c.TLS.ClientCertsFile},
+		{"tls-reload-interval", "TLS_RELOAD_INTERVAL", "how often to check certificate files for changes", &c.TLS.ReloadInterval},
+		{"cors-allowed-origins", "CORS_ALLOWED_ORIGINS", "comma-separated origins allowed to call with gRPC-Web", &c.CORS.AllowedOrigins},
+		{"trash-retention", "TRASH_RETENTION", "how long deleted assets are kept", &c.Trash.Retention},
+		{"purge-interval", "PURGE_INTERVAL", "how often expired deleted assets are purged", &c.Trash.PurgeInterval},
+		{"health-check-interval", "HEALTH_CHECK_INTERVAL", "how often to check the database for health reports", &c.Health.CheckInterval},
+		{"health-check-timeout", "HEALTH_CHECK_TIMEOUT", "timeout of database health checks", &c.Health.CheckTimeout},
+		{"metrics-listen", "METRICS_LISTEN_ADDR", "address to serve /metrics on, empty to disable", &c.Metrics.Listen},
+		{"metrics-portfolio-interval", "METRICS_PORTFOLIO_INTERVAL", "how often to compute the portfolio metrics", &c.Metrics.PortfolioInterval},
+		{"log-level", "LOG_LEVEL", "debug, info, warn or error", &c.Log.Level},
+		{"log-format", "LOG_FORMAT", "text or json", &c.Log.Format},
+	}
+}
+
+// Options are the results of Load besides the configuration itself.
+type Options struct {
+	// PrintConfig asks to print the configuration and exit.
+	PrintConfig bool
+}
+
+// Load builds the configuration from the defaults, the YAML file named by
+// the --config flag or the CONFIG_FILE environment variable, the
+// environment and the command-line arguments args, in increasing order of
+// precedence, and validates it.
+func Load(args []string) (*Config, Options, error) {
+	cfg := Default()
+	var opts Options
+	var file string
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	fs.StringVar(&file, "config", os.Getenv("CONFIG_FILE"), "YAML configuration file (env CONFIG_FILE)")
+	fs.BoolVar(&opts.PrintConfig, "print-config", false, "print the configuration and exit")
+	settings := cfg.settings()
+	for _, s := range settings {
+		usage := fmt.Sprintf("%s (env %s)", s.usage, s.env)
+		switch field := s.field.(type) {
+		case *string:
+			fs.StringVar(field, s.flag, *field, usage)
+		case *bool:
+			fs.BoolVar(field, s.flag, *field, usage)
+		case *time.Duration:
+			fs.DurationVar(field, s.flag, *field, usage)
+		case *uint64:
+			fs.Uint64Var(field, s.flag, *field, usage)
+		case *[]string:
+			fs.Var((*listValue)(field), s.flag, usage)
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, opts, err
+	}
+	if fs.NArg() > 0 {
+		return nil, opts, fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	// Flags were parsed first to find the file; remember them and apply
+	// them again last so that they win.
+	flags := make(map[string]string)
+	fs.Visit(func(f *flag.Flag) { flags[f.Name] = f.Value.String() })
+	if file != "" {
+		if err := cfg.readFile(file); err != nil {
+			return nil, opts, err
+		}
+	}
+	for _, s := range settings {
+		if v, ok := os.LookupEnv(s.env); ok {
+			if err := fs.Set(s.flag, v); err != nil {
+				return nil, opts, fmt.Errorf("%s: %w", s.env, err)
+			}
+		}
+	}
+	for name, v := range flags {
+		if err := fs.Set(name, v); err != nil {
+			return nil, opts, err
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, opts, err
+	}
+	return cfg, opts, nil
+}
+
+// listValue is a flag.Value of comma-separated strings.
+type listValue []string
+
+func (l *listValue) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listValue) Set(s string) error {
+	*l = nil
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
+
+func (c *Config) readFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && err != io.EOF {
+		return fmt.Errorf("parse config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Validate reports all problems with c at once.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+	check(c.Listen != "", "listen address must be set")
+	check(c.HTTPListen != "", "http_listen address must be set")
+	check(c.HTTPListen != c.Listen, "http_listen must differ from listen")
+	check(c.ShutdownTimeout > 0, "shutdown_timeout must be positive")
+	check(strings.HasPrefix(c.Mongo.URI, "mongodb://") || strings.HasPrefix(c.Mongo.URI, "mongodb+srv://"),
+		"mongo.uri must start with mongodb:// or mongodb+srv://")
+	check(c.Mongo.Database != "", "mongo.database must be set")
+	check(c.Mongo.StartupTimeout > 0, "mongo.startup_timeout must be positive")
+	check(c.Mongo.ConnectTimeout >= 0, "mongo.connect_timeout must not be negative")
+	check(c.Mongo.ServerSelectionTimeout >= 0, "mongo.server_selection_timeout must not be negative")
+	check(c.Mongo.SocketTimeout >= 0, "mongo.socket_timeout must not be negative")
+	check(c.Mongo.MaxPoolSize == 0 || c.Mongo.MinPoolSize <= c.Mongo.MaxPoolSize,
+		"mongo.min_pool_size must not exceed mongo.max_pool_size")
+	switch c.Mongo.ReadConcern {
+	case "", "local", "available", "majority", "linearizable":
+	default:
+		check(false, "mongo.read_concern must be local, available, majority or linearizable")
+	}
+	w, err := strconv.Atoi(c.Mongo.WriteConcern)
+	check(c.Mongo.WriteConcern == "" || c.Mongo.WriteConcern == "majority" || err == nil && w >= 0,
+		"mongo.write_concern must be majority or a number of members")
+	seen := make(map[string]bool)
+	for _, name := range []string{
+		c.Mongo.Collections.Assets, c.Mongo.Collections.Revisions, c.Mongo.Collections.AuditEvents,
+		c.Mongo.Collections.Users, c.Mongo.Collections.RefreshTokens, c.Mongo.Collections.Shares,
+		c.Mongo.Collections.APIKeys,
+	} {
+		check(name != "", "mongo.collections must all be set")
+		check(name == "" || !seen[name], "mongo.collections: %q is used twice", name)
+		seen[name] = true
+	}
+	check(c.Deadlines.Default > 0, "deadlines.default must be positive")
+	check(c.Deadlines.Default <= c.Deadlines.Max, "deadlines.default must not exceed deadlines.max")
+	for method, d := range c.Deadlines.Methods {
+		check(isMethod(method), "deadlines.methods: %q is not the full name of a method of the server, like /assets.AssetService/ListAssets", method)
+		check(d.Default >= 0 && d.Max >= 0, "deadlines.methods: %s: deadlines must not be negative", method)
+		defaultDeadline, maxDeadline := d.Default, d.Max
+		if defaultDeadline == 0 {
+			defaultDeadline = c.Deadlines.Default
+		}
+		if maxDeadline == 0 {
+			maxDeadline = c.Deadlines.Max
+		}
+		check(defaultDeadline <= maxDeadline, "deadlines.methods: %s: default must not exceed max", method)
+	}
+	check(c.Auth.HMACSecret != "" || c.Auth.JWKSFile != "", "auth.hmac_secret or auth.jwks_file must be set")
+	check(c.Auth.HMACSecret == "" || len(c.Auth.HMACSecret) >= minHMACSecretLen,
+		"auth.hmac_secret must be at least %d bytes long", minHMACSecretLen)
+	check(c.Auth.AccessTokenTTL > 0, "auth.access_token_ttl must be positive")
+	check(c.Auth.RefreshTokenTTL > 0, "auth.refresh_token_ttl must be positive")
+	check((c.TLS.CertFile == "") == (c.TLS.KeyFile == ""), "tls.cert_file and tls.key_file must be set together")
+	check(c.TLS.ClientCAFile == "" || c.TLS.CertFile != "", "tls.client_ca_file needs tls.cert_file")
+	check(!c.TLS.RequireClientCert || c.TLS.ClientCAFile != "", "tls.require_client_cert needs tls.client_ca_file")
+	check(c.TLS.ClientCertsFile == "" || c.TLS.ClientCAFile != "", "tls.client_certs_file needs tls.client_ca_file")
+	check(c.TLS.ReloadInterval > 0, "tls.reload_interval must be positive")
+	for _, origin := range c.CORS.AllowedOrigins {
+		check(origin == "*" || strings.HasPrefix(origin, "http://") || strings.HasPrefix(origin, "https://"),
+			"cors.allowed_origins: %q is not * or an http(s) origin", origin)
+	}
+	check(c.Trash.Retention > 0, "trash.retention must be positive")
+	check(c.Trash.PurgeInterval > 0, "trash.purge_interval must be positive")
+	check(c.Health.CheckInterval > 0, "health.check_interval must be positive")
+	check(c.Health.CheckTimeout > 0, "health.check_timeout must be positive")
+	check(c.Metrics.Listen == "" || c.Metrics.Listen != c.Listen && c.Metrics.Listen != c.HTTPListen,
+		"metrics.listen must differ from listen and http_listen")
+	check(c.Metrics.PortfolioInterval > 0, "metrics.portfolio_interval must be positive")
+	var level slog.Level
+	check(level.UnmarshalText([]byte(c.Log.Level)) == nil, "log.level must be debug, info, warn or error")
+	check(c.Log.Format == "text" || c.Log.Format == "json", "log.format must be text or json")
+	return errors.Join(errs...)
+}
+
+// Print writes c to w as YAML, in the format of the configuration file.
+// Secrets are redacted.
+func (c *Config) Print(w io.Writer) error {
+	redacted := *c
+	if redacted.Auth.HMACSecret != "" {
+		redacted.Auth.HMACSecret = "REDACTED"
+	}
+	redacted.Mongo.URI = redactUserInfo(redacted.Mongo.URI)
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&redacted); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// redactUserInfo replaces the credentials of a connection string, the part
+// of its host list before "@", with REDACTED.
+func redactUserInfo(uri string) string {
+	scheme, rest, ok := strings.Cut(uri, "://")
+	if !ok {
+		return uri
+	}
+	hosts, path := rest, ""
+	if i := strings.IndexAny(rest, "/?"); i >= 0 {
+		hosts, path = rest[:i], rest[i:]
+	}
+	at := strings.LastIndex(hosts, "@")
+	if at < 0 {
+		return uri
+	}
+	return scheme + "://REDACTED" + hosts[at:] + path
+}
+
+// Logger returns the logger c.Log describes.
+func (c *Config) Logger() *slog.Logger {
+	var level slog.Level
+	level.UnmarshalText([]byte(c.Log.Level))
+	opts := &slog.HandlerOptions{Level: level}
+	if c.Log.Format == "json" {
+		return slog.New(slog.NewJSONHandler(os.Stderr, opts))
+	}
+	return slog.New(slog.NewTextHandler(os.Stderr, opts))
+}
@@ -0,0 +1,263 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestLoadPrecedence(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		env  map[string]string
+		args []string
+		want func(*Config)
+	}{
+		{
+			name: "defaults",
+			want: func(c *Config) {},
+		},
+		{
+			name: "file overrides defaults",
+			file: "listen: :6000\nmetrics:\n  portfolio_interval: 5m\n",
+			want: func(c *Config) {
+				c.Listen = ":6000"
+				c.Metrics.PortfolioInterval = 5 * time.Minute
+			},
+		},
+		{
+			name: "env overrides file",
+			file: "listen: :6000\n",
+			env:  map[string]string{"LISTEN_ADDR": ":7000"},
+			want: func(c *Config) { c.Listen = ":7000" },
+		},
+		{
+			name: "flag overrides env and file",
+			file: "listen: :6000\n",
+			env:  map[string]string{"LISTEN_ADDR": ":7000"},
+			args: []string{"--listen", ":8000"},
+			want: func(c *Config) { c.Listen = ":8000" },
+		},
+		{
+			name: "flag overrides file without env",
+			file: "log:\n  level: debug\n",
+			args: []string{"--log-level", "warn"},
+			want: func(c *Config) { c.Log.Level = "warn" },
+		},
+		{
+			name: "settings from every source combine",
+			file: "mongo:\n  database: fromfile\n",
+			env:  map[string]string{"MONGO_URI": "mongodb://db:27017"},
+			args: []string{"--shutdown-timeout", "5s"},
+			want: func(c *Config) {
+				c.Mongo.Database = "fromfile"
+				c.Mongo.URI = "mongodb://db:27017"
+				c.ShutdownTimeout = 5 * time.Second
+			},
+		},
+		{
+			name: "registration closed by env",
+			env:  map[string]string{"AUTH_ALLOW_REGISTRATION": "false"},
+			want: func(c *Config) { c.Auth.AllowRegistration = false },
+		},
+		{
+			name: "lists from env",
+			env:  map[string]string{"CORS_ALLOWED_ORIGINS": "https://a.example, https://b.example"},
+			want: func(c *Config) { c.CORS.AllowedOrigins = []string{"https://a.example", "https://b.example"} },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("JWT_HS256_SECRET", testSecret)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			args := tt.args
+			if tt.file != "" {
+				path := filepath.Join(t.TempDir(), "server.yaml")
+				if err := os.WriteFile(path, []byte(tt.file), 0o600); err != nil {
+					t.Fatal(err)
+				}
+				args = append([]string{"--config", path}, args...)
+			}
+			got, _, err := Load(args)
+			if err != nil {
+				t.Fatalf("Load() error: %v", err)
+			}
+			want := Default()
+			want.Auth.HMACSecret = testSecret
+			tt.want(want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Load() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		env     map[string]string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "unknown file key",
+			file:    "listn: :6000\n",
+			wantErr: "field listn not found",
+		},
+		{
+			name:    "bad env value",
+			env:     map[string]string{"SHUTDOWN_TIMEOUT": "soon"},
+			wantErr: "SHUTDOWN_TIMEOUT",
+		},
+		{
+			name:    "unexpected argument",
+			args:    []string{"extra"},
+			wantErr: "unexpected arguments: extra",
+		},
+		{
+			name:    "invalid flag value fails validation",
+			args:    []string{"--log-format", "xml"},
+			wantErr: "log.format must be text or json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("JWT_HS256_SECRET", testSecret)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			args := tt.args
+			if tt.file != "" {
+				path := filepath.Join(t.TempDir(), "server.yaml")
+				if err := os.WriteFile(path, []byte(tt.file), 0o600); err != nil {
+					t.Fatal(err)
+				}
+				args = append([]string{"--config", path}, args...)
+			}
+			_, _, err := Load(args)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load() error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRedactUserInfo(t *testing.T) {
+	tests := []struct {
+		uri, want string
+	}{
+		{"mongodb://localhost:27017", "mongodb://localhost:27017"},
+		{"mongodb://user:secret@db:27017/assetdb?authSource=admin", "mongodb://REDACTED@db:27017/assetdb?authSource=admin"},
+		{"mongodb+srv://user:p@ss@cluster.example", "mongodb+srv://REDACTED@cluster.example"},
+		{"mongodb://db/?appName=a@b", "mongodb://db/?appName=a@b"},
+	}
+	for _, tt := range tests {
+		if got := redactUserInfo(tt.uri); got != tt.want {
+			t.Errorf("redactUserInfo(%q) = %q, want %q", tt.uri, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr string
+	}{
+		{
+			name:   "defaults with a secret",
+			modify: func(c *Config) {},
+		},
+		{
+			name:    "short secret",
+			modify:  func(c *Config) { c.Auth.HMACSecret = "secret" },
+			wantErr: "auth.hmac_secret must be at least 32 bytes long",
+		},
+		{
+			name: "short secret beside a JWK set",
+			modify: func(c *Config) {
+				c.Auth.HMACSecret = "secret"
+				c.Auth.JWKSFile = "keys.json"
+			},
+			wantErr: "auth.hmac_secret must be at least 32 bytes long",
+		},
+		{
+			name: "JWK set without a secret",
+			modify: func(c *Config) {
+				c.Auth.HMACSecret = ""
+				c.Auth.JWKSFile = "keys.json"
+			},
+		},
+		{
+			name:    "no keys",
+			modify:  func(c *Config) { c.Auth.HMACSecret = "" },
+			wantErr: "auth.hmac_secret or auth.jwks_file must be set",
+		},
+		{
+			name: "deadline of a method",
+			modify: func(c *Config) {
+				c.Deadlines.Methods = map[string]MethodDeadlines{
+					"/assets.AssetService/BatchCreateAssets": {Default: time.Minute, Max: 5 * time.Minute},
+					"/grpc.health.v1.Health/Watch":           {Max: time.Hour},
+				}
+				c.Deadlines.Max = time.Hour
+			},
+		},
+		{
+			name: "deadline of an unknown method",
+			modify: func(c *Config) {
+				c.Deadlines.Methods = map[string]MethodDeadlines{"/assets.AssetService/BatchCreate": {Default: time.Minute}}
+			},
+			wantErr: `deadlines.methods: "/assets.AssetService/BatchCreate" is not the full name of a method of the server`,
+		},
+		{
+			name: "deadline without the leading slash",
+			modify: func(c *Config) {
+				c.Deadlines.Methods = map[string]MethodDeadlines{"assets.AssetService/ListAssets": {Default: time.Minute}}
+			},
+			wantErr: "is not the full name of a method of the server",
+		},
+		{
+			name: "method default above its max",
+			modify: func(c *Config) {
+				c.Deadlines.Methods = map[string]MethodDeadlines{"/assets.AssetService/ListAssets": {Default: 3 * time.Minute}}
+			},
+			wantErr: "deadlines.methods: /assets.AssetService/ListAssets: default must not exceed max",
+		},
+		{
+			name:    "metrics on the API listener",
+			modify:  func(c *Config) { c.Metrics.Listen = c.HTTPListen },
+			wantErr: "metrics.listen must differ from listen and http_listen",
+		},
+		{
+			name:   "metrics disabled",
+			modify: func(c *Config) { c.Metrics.Listen = "" },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Default()
+			c.Auth.HMACSecret = testSecret
+			tt.modify(c)
+			err := c.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Package deadline bounds how long every unary RPC may run. Calls without a
+// deadline get a default one and calls asking for more than a maximum are
+// cut down to it, so that no call holds a cursor or connection forever.
+package deadline
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"google.golang.org/grpc"
+)
+
+// DetachedTimeout bounds the writes made with Detached.
+const DetachedTimeout = 10 * time.Second
+
+// Limits bound the deadline of calls.
+type Limits struct {
+	// Default is the deadline of calls whose client sets none.
+	Default time.Duration
+	// Max caps the deadline clients may set.
+	Max time.Duration
+}
+
+// merge returns l with its zero fields taken from defaults.
+func (l Limits) merge(defaults Limits) Limits {
+	if l.Default == 0 {
+		l.Default = defaults.Default
+	}
+	if l.Max == 0 {
+		l.Max = defaults.Max
+	}
+	return l
+}
+
+// UnaryServerInterceptor applies the limits of methods, keyed by full
+// method name, or defaults for methods not listed, to the handler context.
+// Zero fields of a method's limits fall back to defaults. A call failing
+// once its deadline has passed fails with DeadlineExceeded, whatever error
+// the handler returned.
+//
+// Streams are not bounded: the only ones served, Health.Watch and server
+// reflection, are meant to stay open.
+func UnaryServerInterceptor(defaults Limits, methods map[string]Limits) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		limits := methods[info.FullMethod].merge(defaults)
+		timeout := limits.Default
+		if d, ok := ctx.Deadline(); ok {
+			timeout = min(time.Until(d), limits.Max)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		resp, err := handler(ctx, req)
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, grpcerr.DeadlineExceeded()
+		}
+		return resp, err
+	}
+}
+
+// Detached returns a context for writes that must complete even if the call
+// of ctx is canceled or runs out of time, such as audit records of a change
+// already made. They are bounded by DetachedTimeout instead.
+func Detached(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), DetachedTimeout)
+}
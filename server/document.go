@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/principal"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/rbac"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/sharing"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// assetDocument is the shape of an asset stored in the assets collection.
+type assetDocument struct {
+	ID primitive.ObjectID `bson:"_id"`
+	// Owner is the subject of the user whose portfolio holds the asset.
+	Owner    string  `bson:"owner"`
+	Symbol   string  `bson:"symbol"`
+	Quantity int32   `bson:"quantity"`
+	Price    float64 `bson:"price"`
+	Version  int64   `bson:"version"`
+	// DeletedAt is set while the asset is in the trash.
+	DeletedAt *time.Time `bson:"deleted_at"`
+	CreatedAt time.Time  `bson:"created_at"`
+	UpdatedAt time.Time  `bson:"updated_at"`
+	CreatedBy string     `bson:"created_by"`
+	UpdatedBy string     `bson:"updated_by"`
+}
+
+// newAssetDocument returns the first version of an asset created by the
+// caller of ctx at now in the portfolio of owner.
+func newAssetDocument(ctx context.Context, owner string, now time.Time, symbol string, quantity int32, price float64) *assetDocument {
+	actor := principal.Name(ctx)
+	return &assetDocument{
+		ID:        primitive.NewObjectID(),
+		Owner:     owner,
+		Symbol:    symbol,
+		Quantity:  quantity,
+		Price:     price,
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+		CreatedBy: actor,
+		UpdatedBy: actor,
+	}
+}
+
+// touch records a write of d by the caller of ctx at now, mirroring what
+// stampUpdate does in the database.
+func (d *assetDocument) touch(ctx context.Context, now time.Time) {
+	d.Version++
+	d.UpdatedAt = now
+	d.UpdatedBy = principal.Name(ctx)
+}
+
+func (d *assetDocument) toProto() *asset.Asset {
+	a := &asset.Asset{
+		Id:         d.ID.Hex(),
+		Symbol:     d.Symbol,
+		Quantity:   d.Quantity,
+		Price:      d.Price,
+		Version:    d.Version,
+		Owner:      d.Owner,
+		CreatedBy:  d.CreatedBy,
+		UpdatedBy:  d.UpdatedBy,
+		CreateTime: timestamppb.New(d.CreatedAt),
+		UpdateTime: timestamppb.New(d.UpdatedAt),
+	}
+	if d.DeletedAt != nil {
+		a.DeleteTime = timestamppb.New(*d.DeletedAt)
+	}
+	return a
+}
+
+// writeTime returns the current time at the millisecond precision MongoDB
+// stores, so documents echoed back to clients match what is read later.
+func writeTime() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+// stampUpdate returns an update setting the given fields, bumping the
+// version and recording the caller of ctx as the last writer.
+func stampUpdate(ctx context.Context, now time.Time, set bson.M) bson.M {
+	set["updated_at"] = now
+	set["updated_by"] = principal.Name(ctx)
+	return bson.M{
+		"$set": set,
+		"$inc": bson.M{"version": int64(1)},
+	}
+}
+
+// assetSort translates a ListAssetsRequest order_by value into a sort
+// document. The value has already been validated.
+func assetSort(orderBy string) bson.D {
+	field, desc := strings.CutSuffix(orderBy, " desc")
+	key := map[string]string{
+		"symbol":      "symbol",
+		"create_time": "created_at",
+		"update_time": "updated_at",
+	}[field]
+	dir := 1
+	if desc {
+		dir = -1
+	}
+	return bson.D{{Key: key, Value: dir}, {Key: "_id", Value: dir}}
+}
+
+// readable restricts filter to the assets the caller of ctx may read: those
+// of their own portfolio and of the portfolios shared with them. Every read
+// on behalf of a caller must go through it, so that other assets look like
+// they do not exist.
+func readable(ctx context.Context, filter bson.M) bson.M {
+	filter["owner"] = bson.M{"$in": sharing.Owners(ctx, rbac.ReadAssets)}
+	return filter
+}
+
+// writable is like readable for the assets the caller of ctx may change.
+func writable(ctx context.Context, filter bson.M) bson.M {
+	filter["owner"] = bson.M{"$in": sharing.Owners(ctx, rbac.WriteAssets)}
+	return filter
+}
+
+// targetOwner returns the owner of the portfolio an asset is created in:
+// owner if set, otherwise the caller of ctx. It fails with PermissionDenied
+// if the caller may not write to that portfolio.
+func targetOwner(ctx context.Context, owner string) (string, error) {
+	if owner == "" {
+		return principal.Name(ctx), nil
+	}
+	if !sharing.Allowed(ctx, owner, rbac.WriteAssets) {
+		return "", status.Errorf(codes.PermissionDenied, "portfolio of %q is not shared with you as editor", owner)
+	}
+	return owner, nil
+}
+
+// versionFilter matches the live asset the caller of ctx may change with the
+// given id at the given version. Documents written before versioning have no
+// version field and are treated as version 0.
+func versionFilter(ctx context.Context, id primitive.ObjectID, version int64) bson.M {
+	if version == 0 {
+		return writable(ctx, bson.M{"_id": id, "version": bson.M{"$in": bson.A{0, nil}}, "deleted_at": nil})
+	}
+	return writable(ctx, bson.M{"_id": id, "version": version, "deleted_at": nil})
+}
+
+// writeConflict explains why a write with the given versioned filter matched
+// no document: either the asset does not exist or it is at a different
+// version.
+func (s *server) writeConflict(ctx context.Context, assetCollection *mongo.Collection, filter bson.M) error {
+	id := filter["_id"].(primitive.ObjectID)
+	unversioned := bson.M{}
+	for k, v := range filter {
+		if k != "version" {
+			unversioned[k] = v
+		}
+	}
+	var current assetDocument
+	err := assetCollection.FindOne(ctx, unversioned).Decode(&current)
+	if err != nil {
+		return grpcerr.FromMongo(err, "asset", id.Hex())
+	}
+	return grpcerr.VersionMismatch("asset", id.Hex(), current.Version)
+}
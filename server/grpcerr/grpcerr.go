@@ -0,0 +1,202 @@
+// Package grpcerr maps storage and request errors to gRPC status errors with
+// google.rpc error details attached, so clients can branch on the code and
+// reason instead of parsing messages.
+package grpcerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Domain is reported in every ErrorInfo detail produced by this package.
+const Domain = "assets.portfolio"
+
+// Reasons used in ErrorInfo details.
+const (
+	ReasonNotFound         = "NOT_FOUND"
+	ReasonAlreadyExists    = "ALREADY_EXISTS"
+	ReasonInvalidArgument  = "INVALID_ARGUMENT"
+	ReasonUnavailable      = "DATABASE_UNAVAILABLE"
+	ReasonDeadlineExceeded = "DEADLINE_EXCEEDED"
+	ReasonVersionMismatch  = "VERSION_MISMATCH"
+	ReasonUnauthenticated  = "UNAUTHENTICATED"
+	ReasonPermissionDenied = "PERMISSION_DENIED"
+	ReasonNoTransactions   = "TRANSACTIONS_UNSUPPORTED"
+)
+
+// FieldViolation describes a single invalid request field.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// NotFound reports that the resource with the given id does not exist.
+func NotFound(resource, id string) error {
+	return withInfo(codes.NotFound, fmt.Sprintf("%s %q not found", resource, id), ReasonNotFound, map[string]string{
+		"resource": resource,
+		"id":       id,
+	})
+}
+
+// AlreadyExists reports a conflict with an existing resource.
+func AlreadyExists(resource, key string) error {
+	return withInfo(codes.AlreadyExists, fmt.Sprintf("%s %q already exists", resource, key), ReasonAlreadyExists, map[string]string{
+		"resource": resource,
+		"key":      key,
+	})
+}
+
+// VersionMismatch reports that a write was made against a stale version of
+// the resource. The current version is included so clients can refetch and
+// retry.
+func VersionMismatch(resource, id string, current int64) error {
+	return withInfo(codes.Aborted, fmt.Sprintf("%s %q was modified concurrently, current version is %d", resource, id, current), ReasonVersionMismatch, map[string]string{
+		"resource":        resource,
+		"id":              id,
+		"current_version": strconv.FormatInt(current, 10),
+	})
+}
+
+// Unauthenticated reports a call without valid credentials. reason says
+// what was wrong with them.
+func Unauthenticated(reason string) error {
+	return withInfo(codes.Unauthenticated, reason, ReasonUnauthenticated, nil)
+}
+
+// PermissionDenied reports that the caller may not call method. permission
+// names what they lack, if the method can be called at all.
+func PermissionDenied(method, permission string) error {
+	msg := fmt.Sprintf("not allowed to call %s", method)
+	metadata := map[string]string{"method": method}
+	if permission != "" {
+		msg += fmt.Sprintf(", requires %s", permission)
+		metadata["permission"] = permission
+	}
+	return withInfo(codes.PermissionDenied, msg, ReasonPermissionDenied, metadata)
+}
+
+// DeadlineExceeded reports that the call ran out of time.
+func DeadlineExceeded() error {
+	return withInfo(codes.DeadlineExceeded, "deadline exceeded", ReasonDeadlineExceeded, nil)
+}
+
+// InvalidArgument reports a single invalid field.
+func InvalidArgument(field, description string) error {
+	return BadRequest(FieldViolation{Field: field, Description: description})
+}
+
+// InvalidID reports a malformed document id in the given request field.
+func InvalidID(field, id string) error {
+	return InvalidArgument(field, fmt.Sprintf("%q is not a valid id", id))
+}
+
+// BadRequest reports one or more invalid fields as an InvalidArgument error
+// carrying a google.rpc.BadRequest detail.
+func BadRequest(violations ...FieldViolation) error {
+	msg := "invalid request"
+	if len(violations) > 0 {
+		msg = fmt.Sprintf("invalid %s: %s", violations[0].Field, violations[0].Description)
+		if len(violations) > 1 {
+			msg += fmt.Sprintf(" (and %d more)", len(violations)-1)
+		}
+	}
+	br := &errdetails.BadRequest{}
+	for _, v := range violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	info := &errdetails.ErrorInfo{Reason: ReasonInvalidArgument, Domain: Domain}
+	return withDetails(status.New(codes.InvalidArgument, msg), br, info)
+}
+
+// FromMongo converts an error returned by the MongoDB driver into a status
+// error. resource and id describe the document the operation was about and
+// are used for NotFound and AlreadyExists errors. Errors that already carry a
+// gRPC status are returned unchanged.
+func FromMongo(err error, resource, id string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return NotFound(resource, id)
+	case mongo.IsDuplicateKeyError(err):
+		return AlreadyExists(resource, id)
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "request canceled")
+	case errors.Is(err, context.DeadlineExceeded), mongo.IsTimeout(err):
+		return DeadlineExceeded()
+	case mongo.IsNetworkError(err), isServerSelectionError(err), errors.Is(err, mongo.ErrClientDisconnected):
+		return withInfo(codes.Unavailable, "database unavailable", ReasonUnavailable, nil)
+	case isTransactionsUnsupported(err):
+		return withInfo(codes.FailedPrecondition,
+			"atomic writes need MongoDB to run as a replica set or sharded cluster; retry without atomic",
+			ReasonNoTransactions, nil)
+	}
+	slog.Error("Unmapped database error", "err", err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+// UnaryServerInterceptor converts any error a handler returns without a gRPC
+// status through FromMongo so that raw driver errors never reach clients.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, FromMongo(err, "resource", "")
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return FromMongo(handler(srv, ss), "resource", "")
+	}
+}
+
+// isTransactionsUnsupported reports whether err is the IllegalOperation
+// error a standalone server answers transactions with.
+func isTransactionsUnsupported(err error) bool {
+	var se mongo.ServerError
+	return errors.As(err, &se) && se.HasErrorCodeWithMessage(20, "Transaction numbers")
+}
+
+func isServerSelectionError(err error) bool {
+	var sse topology.ServerSelectionError
+	return errors.As(err, &sse)
+}
+
+func withInfo(code codes.Code, msg, reason string, metadata map[string]string) error {
+	return withDetails(status.New(code, msg), &errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   Domain,
+		Metadata: metadata,
+	})
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
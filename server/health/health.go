@@ -0,0 +1,88 @@
+// Package health reports whether the server and the dependencies it needs
+// are up through the standard grpc.health.v1.Health service, so that
+// grpcurl and Kubernetes gRPC probes can check it.
+package health
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// MongoDB is the health service name reporting whether the database is
+// reachable.
+const MongoDB = "mongodb"
+
+// Checker keeps the health statuses up to date.
+type Checker struct {
+	server   *health.Server
+	client   *mongo.Client
+	services []string
+}
+
+// NewChecker reports on the database of client and on services, the full
+// names of the gRPC services needing it. The empty service name reports on
+// the server as a whole. Everything is NOT_SERVING until the first check.
+func NewChecker(client *mongo.Client, services ...string) *Checker {
+	c := &Checker{server: health.NewServer(), client: client, services: services}
+	c.set(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// Server returns the Health service to register.
+func (c *Checker) Server() healthpb.HealthServer {
+	return c.server
+}
+
+// Run pings the database every interval until ctx is canceled, giving up
+// on each ping after timeout. Changes of reachability are logged.
+func (c *Checker) Run(ctx context.Context, interval, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var serving, checked bool
+	for {
+		pingCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := c.client.Ping(pingCtx, readpref.Primary())
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil && (serving || !checked) {
+			slog.Error("MongoDB is unreachable", "err", err)
+			c.set(healthpb.HealthCheckResponse_NOT_SERVING)
+		} else if err == nil && !serving {
+			if checked {
+				slog.Info("MongoDB is reachable again")
+			}
+			c.set(healthpb.HealthCheckResponse_SERVING)
+		}
+		if err == nil {
+			slog.Debug("MongoDB answered the health check")
+		}
+		serving, checked = err == nil, true
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown reports every service as NOT_SERVING for good, so that clients
+// move elsewhere while the server drains.
+func (c *Checker) Shutdown() {
+	c.server.Shutdown()
+}
+
+func (c *Checker) set(status healthpb.HealthCheckResponse_ServingStatus) {
+	c.server.SetServingStatus("", status)
+	c.server.SetServingStatus(MongoDB, status)
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
@@ -0,0 +1,127 @@
+// Package history stores a revision of an asset document for every write so
+// that holdings can be read as they were at any point in time.
+package history
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Revision is the state of an asset from Time until the next revision of the
+// same asset. Document is the full asset document after the write.
+type Revision struct {
+	ID primitive.ObjectID `bson:"_id,omitempty"`
+	// Owner is the owner of the asset, so that history reads can be scoped
+	// to a portfolio like reads of current assets.
+	Owner   string             `bson:"owner"`
+	AssetID primitive.ObjectID `bson:"asset_id"`
+	Time    time.Time          `bson:"time"`
+	// Version is the version of the asset after the write. Writers take
+	// their time before writing, so concurrent writes may be stored with
+	// times out of order; of the revisions in effect at a time, the one
+	// with the highest version is the latest.
+	Version  int64    `bson:"version"`
+	Document bson.Raw `bson:"document"`
+}
+
+// Store reads and writes revisions.
+type Store struct {
+	revisions *mongo.Collection
+}
+
+func NewStore(revisions *mongo.Collection) *Store {
+	return &Store{revisions: revisions}
+}
+
+// Write is a write of the asset AssetID, owned by Owner, that left it as
+// Doc at Version from Time on.
+type Write struct {
+	Owner   string
+	AssetID primitive.ObjectID
+	Time    time.Time
+	Version int64
+	Doc     interface{}
+}
+
+// AppendAll stores a revision for each of writes in a single insert.
+func (s *Store) AppendAll(ctx context.Context, writes []Write) error {
+	revisions := make([]interface{}, len(writes))
+	for i, w := range writes {
+		raw, err := bson.Marshal(w.Doc)
+		if err != nil {
+			return err
+		}
+		revisions[i] = Revision{Owner: w.Owner, AssetID: w.AssetID, Time: w.Time, Version: w.Version, Document: raw}
+	}
+	if len(revisions) == 0 {
+		return nil
+	}
+	_, err := s.revisions.InsertMany(ctx, revisions, options.InsertMany().SetOrdered(false))
+	return err
+}
+
+// Unrecorded returns a cursor over the documents of assets that have no
+// revision stored yet.
+func (s *Store) Unrecorded(ctx context.Context, assets *mongo.Collection) (*mongo.Cursor, error) {
+	return assets.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$lookup", Value: bson.M{
+			"from": s.revisions.Name(),
+			"let":  bson.M{"id": "$_id"},
+			"pipeline": mongo.Pipeline{
+				{{Key: "$match", Value: bson.M{"$expr": bson.M{"$eq": bson.A{"$asset_id", "$$id"}}}}},
+				{{Key: "$limit", Value: 1}},
+				{{Key: "$project", Value: bson.M{"_id": 1}}},
+			},
+			"as": "revisions",
+		}}},
+		{{Key: "$match", Value: bson.M{"revisions": bson.M{"$size": 0}}}},
+		{{Key: "$project", Value: bson.M{"revisions": 0}}},
+	})
+}
+
+// latestFirst sorts the revisions of an asset from the latest to the
+// earliest.
+var latestFirst = bson.D{{Key: "version", Value: -1}, {Key: "time", Value: -1}, {Key: "_id", Value: -1}}
+
+// At returns the document of assetID as it was at t, if it belongs to one of
+// owners. It returns mongo.ErrNoDocuments if the asset did not exist yet.
+func (s *Store) At(ctx context.Context, owners []string, assetID primitive.ObjectID, t time.Time) (bson.Raw, error) {
+	opts := options.FindOne().SetSort(latestFirst)
+	var rev Revision
+	filter := bson.M{"owner": bson.M{"$in": owners}, "asset_id": assetID, "time": bson.M{"$lte": t}}
+	err := s.revisions.FindOne(ctx, filter, opts).Decode(&rev)
+	if err != nil {
+		return nil, err
+	}
+	return rev.Document, nil
+}
+
+// AllAt returns the document of every asset of owners as it was at t,
+// including assets that were in the trash at the time, in the order of sort
+// over the document fields, or unordered if sort is nil.
+func (s *Store) AllAt(ctx context.Context, owners []string, t time.Time, sort bson.D) ([]bson.Raw, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"owner": bson.M{"$in": owners}, "time": bson.M{"$lte": t}}}},
+		{{Key: "$sort", Value: append(bson.D{{Key: "asset_id", Value: 1}}, latestFirst...)}},
+		{{Key: "$group", Value: bson.M{"_id": "$asset_id", "document": bson.M{"$first": "$document"}}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$document"}}},
+	}
+	if sort != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$sort", Value: sort}})
+	}
+	cursor, err := s.revisions.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []bson.Raw
+	for cursor.Next(ctx) {
+		docs = append(docs, append(bson.Raw(nil), cursor.Current...))
+	}
+	return docs, cursor.Err()
+}
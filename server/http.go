@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/audit"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/auth"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// gatewayBufferSize is the buffer size of the in-memory connection between
+// the REST gateway and the gRPC server.
+const gatewayBufferSize = 1 << 20
+
+// httpHandler serves gRPC-Web requests and their CORS preflights through the
+// gRPC-Web wrapper of the gRPC server and everything else through fallback.
+// Native gRPC clients use the gRPC listener instead.
+type httpHandler struct {
+	grpcWeb  *grpcweb.WrappedGrpcServer
+	fallback http.Handler
+}
+
+// newHTTPHandler serves grpcServer to browsers. Browsers on the pages of
+// allowedOrigins, or of any origin if it contains "*", may call it across
+// origins.
+func newHTTPHandler(grpcServer *grpc.Server, allowedOrigins []string, fallback http.Handler) *httpHandler {
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowed[origin] = true
+	}
+	return &httpHandler{
+		grpcWeb: grpcweb.WrapServer(grpcServer,
+			grpcweb.WithOriginFunc(func(origin string) bool {
+				return allowed["*"] || allowed[origin]
+			}),
+		),
+		fallback: fallback,
+	}
+}
+
+func (h *httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.grpcWeb.IsGrpcWebRequest(r) || h.grpcWeb.IsAcceptableGrpcCorsRequest(r) {
+		h.grpcWeb.ServeHTTP(w, r)
+		return
+	}
+	h.fallback.ServeHTTP(w, r)
+}
+
+// newGateway returns the REST mapping of AssetService. It calls grpcServer
+// over an in-memory connection, so REST calls pass the same interceptors as
+// gRPC calls, and passes the address of the HTTP client along for the audit
+// log. Every service must be registered with grpcServer before.
+// Requests authenticate with the Authorization or X-Api-Key header; client
+// certificates do not reach the gRPC server.
+func newGateway(ctx context.Context, grpcServer *grpc.Server) (http.Handler, error) {
+	lis := bufconn.Listen(gatewayBufferSize)
+	go grpcServer.Serve(gatewayListener{lis})
+	conn, err := grpc.NewClient("passthrough:///gateway",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			switch k := strings.ToLower(key); k {
+			case auth.APIKeyMetadataKey, requestid.MetadataKey:
+				return k, true
+			}
+			k, ok := runtime.DefaultHeaderMatcher(key)
+			// Only the gateway may say who the client is.
+			if strings.EqualFold(k, audit.GatewayClientAddressKey) {
+				return "", false
+			}
+			return k, ok
+		}),
+		runtime.WithMetadata(func(_ context.Context, r *http.Request) metadata.MD {
+			return metadata.Pairs(audit.GatewayClientAddressKey, r.RemoteAddr)
+		}),
+	)
+	if err := asset.RegisterAssetServiceHandler(ctx, mux, conn); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
+
+// gatewayListener marks the connections of the REST gateway, which stay in
+// memory and are not encrypted.
+type gatewayListener struct {
+	net.Listener
+}
+
+func (l gatewayListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return gatewayConn{conn}, nil
+}
+
+type gatewayConn struct {
+	net.Conn
+}
+
+// serverCredentials secures the connections of native gRPC clients with
+// creds and lets those of the REST gateway through in plaintext.
+type serverCredentials struct {
+	credentials.TransportCredentials
+}
+
+func (c serverCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if _, ok := conn.(gatewayConn); ok {
+		return insecure.NewCredentials().ServerHandshake(conn)
+	}
+	return c.TransportCredentials.ServerHandshake(conn)
+}
+
+func (c serverCredentials) Clone() credentials.TransportCredentials {
+	return serverCredentials{c.TransportCredentials.Clone()}
+}
@@ -2,141 +2,584 @@ package main
 
 import (
 	"context"
-	"log"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"log/slog"
+	"math"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/jonathan-dotcom/asset-portfolio-management/frontend"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/apikeys"
 	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/audit"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/auth"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/config"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/deadline"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/health"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/history"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/metrics"
 	"github.com/jonathan-dotcom/asset-portfolio-management/server/mongodb"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/openapi"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/rbac"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/requestid"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/sharing"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/spa"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/tlsreload"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/users"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/validate"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionalphapb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
 )
 
+// publicMethods can be called without authentication: the account methods,
+// which hand out credentials, and the health and reflection services used
+// by probes and tools.
+var publicMethods = append([]string{
+	healthpb.Health_Check_FullMethodName,
+	healthpb.Health_Watch_FullMethodName,
+	reflectionpb.ServerReflection_ServerReflectionInfo_FullMethodName,
+	reflectionalphapb.ServerReflection_ServerReflectionInfo_FullMethodName,
+}, accountMethods...)
+
 const (
-  port = ":50051"
-  mongoURI = "mongodb://localhost:27017"
+	// maxUpsertAttempts bounds how often UpsertAsset retries after losing a
+	// race with another writer.
+	maxUpsertAttempts = 5
+
+	// disconnectTimeout bounds closing the MongoDB connections on shutdown.
+	disconnectTimeout = 5 * time.Second
+
+	// backfillTimeout bounds giving old assets their first revisions at
+	// startup, and backfillBatchSize is the number of revisions inserted
+	// at once.
+	backfillTimeout   = time.Minute
+	backfillBatchSize = 1000
 )
 
 type server struct {
-  asset.UnimplementedAssetServiceServer
-  mongoClient *mongo.Client
+	asset.UnimplementedAssetServiceServer
+	mongoClient *mongo.Client
+	assets      *mongo.Collection
+	audit       *audit.Recorder
+	history     *history.Store
 }
 
 func (s *server) CreateAsset(ctx context.Context, req *asset.CreateAssetRequest) (*asset.Asset, error) {
-  assetCollection := s.mongoClient.Database("assetdb").Collection("assets")
-  res, err := assetCollection.InsertOne(ctx, bson.M{
-    "symbol":   req.Symbol,
-    "quantity": req.Quantity,
-    "price":    req.Price,
-  })
-  if err != nil {
-    return nil, err
-  }
-  id := res.InsertedID.(primitive.ObjectID).Hex()
-  return &asset.Asset{
-    Id:       id,
-    Symbol:   req.Symbol,
-    Quantity: req.Quantity,
-    Price:    req.Price,
-  }, nil
+	assetCollection := s.assets
+	owner, err := targetOwner(ctx, req.Owner)
+	if err != nil {
+		return nil, err
+	}
+	doc := newAssetDocument(ctx, owner, writeTime(), req.Symbol, req.Quantity, req.Price)
+	_, err = assetCollection.InsertOne(ctx, doc)
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", req.Symbol)
+	}
+	s.recordChange(ctx, nil, doc)
+	return doc.toProto(), nil
+}
+
+// UpsertAsset merges the request into the live position for the symbol,
+// creating it if there is none. The read and the versioned write are retried
+// when another writer gets in between, so concurrent upserts of the same
+// symbol never lose quantity.
+func (s *server) UpsertAsset(ctx context.Context, req *asset.UpsertAssetRequest) (*asset.Asset, error) {
+	assetCollection := s.assets
+	owner, err := targetOwner(ctx, req.Owner)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"owner": owner, "symbol": req.Symbol, "deleted_at": nil}
+	for attempt := 0; attempt < maxUpsertAttempts; attempt++ {
+		var before assetDocument
+		err := assetCollection.FindOne(ctx, filter, options.FindOne().SetCollation(mongodb.SymbolCollation)).Decode(&before)
+		if err == mongo.ErrNoDocuments {
+			after := newAssetDocument(ctx, owner, writeTime(), req.Symbol, req.Quantity, req.Price)
+			_, err := assetCollection.InsertOne(ctx, after)
+			if mongo.IsDuplicateKeyError(err) {
+				continue
+			}
+			if err != nil {
+				return nil, grpcerr.FromMongo(err, "asset", req.Symbol)
+			}
+			s.recordChange(ctx, nil, after)
+			return after.toProto(), nil
+		}
+		if err != nil {
+			return nil, grpcerr.FromMongo(err, "asset", req.Symbol)
+		}
+
+		after := before
+		after.Quantity, after.Price, err = mergePosition(before.Quantity, before.Price, req.Quantity, req.Price)
+		if err != nil {
+			return nil, err
+		}
+		after.touch(ctx, writeTime())
+		res, err := assetCollection.UpdateOne(ctx, versionFilter(ctx, before.ID, before.Version), bson.M{
+			"$set": bson.M{
+				"quantity":   after.Quantity,
+				"price":      after.Price,
+				"version":    after.Version,
+				"updated_at": after.UpdatedAt,
+				"updated_by": after.UpdatedBy,
+			},
+		})
+		if err != nil {
+			return nil, grpcerr.FromMongo(err, "asset", req.Symbol)
+		}
+		if res.MatchedCount == 0 {
+			continue
+		}
+		s.recordChange(ctx, &before, &after)
+		return after.toProto(), nil
+	}
+	return nil, status.Errorf(codes.Aborted, "asset %q is being modified concurrently, retry later", req.Symbol)
+}
+
+// mergePosition adds quantity at price to an existing position and returns
+// the new quantity and its quantity-weighted average price. It fails if the
+// new quantity does not fit in a quantity field.
+func mergePosition(oldQuantity int32, oldPrice float64, quantity int32, price float64) (int32, float64, error) {
+	total := int64(oldQuantity) + int64(quantity)
+	if total > math.MaxInt32 {
+		return 0, 0, grpcerr.InvalidArgument("quantity", fmt.Sprintf("adding %d to the position of %d exceeds the maximum quantity of %d", quantity, oldQuantity, math.MaxInt32))
+	}
+	if total <= 0 {
+		return int32(total), price, nil
+	}
+	return int32(total), (float64(oldQuantity)*oldPrice + float64(quantity)*price) / float64(total), nil
 }
 
 func (s *server) GetAsset(ctx context.Context, req *asset.GetAssetRequest) (*asset.Asset, error) {
-    assetCollection := s.mongoClient.Database("assetdb").Collection("assets")
-    var result asset.Asset
-    objID, err := primitive.ObjectIDFromHex(req.Id)
-    if err != nil {
-        return nil, err
-    }
-    err = assetCollection.FindOne(ctx, bson.M{"_id": objID}).Decode(&result)
-    if err != nil {
-        return nil, err
-    }
-    result.Id = req.Id
-    return &result, nil
+	assetCollection := s.assets
+	var result assetDocument
+	objID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, grpcerr.InvalidID("id", req.Id)
+	}
+	if req.AsOf != nil {
+		return s.getAssetAsOf(ctx, objID, req.AsOf.AsTime())
+	}
+	err = assetCollection.FindOne(ctx, readable(ctx, bson.M{"_id": objID, "deleted_at": nil})).Decode(&result)
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", req.Id)
+	}
+	return result.toProto(), nil
 }
 
 func (s *server) UpdateAsset(ctx context.Context, req *asset.UpdateAssetRequest) (*asset.Asset, error) {
-    assetCollection := s.mongoClient.Database("assetdb").Collection("assets")
-    objID, err := primitive.ObjectIDFromHex(req.Id)
-    if err != nil {
-        return nil, err
-    }
-    update := bson.M{
-        "$set": bson.M{
-            "symbol":   req.Symbol,
-            "quantity": req.Quantity,
-            "price":    req.Price,
-        },
-    }
-    _, err = assetCollection.UpdateOne(ctx, bson.M{"_id": objID}, update)
-    if err != nil {
-        return nil, err
-    }
-    return s.GetAsset(ctx, &asset.GetAssetRequest{Id: req.Id})
+	assetCollection := s.assets
+	objID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, grpcerr.InvalidID("id", req.Id)
+	}
+	now := writeTime()
+	update := stampUpdate(ctx, now, bson.M{
+		"symbol":   req.Symbol,
+		"quantity": req.Quantity,
+		"price":    req.Price,
+	})
+	var before assetDocument
+	filter := versionFilter(ctx, objID, req.Version)
+	err = assetCollection.FindOneAndUpdate(ctx, filter, update).Decode(&before)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.writeConflict(ctx, assetCollection, filter)
+	}
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", req.Symbol)
+	}
+	after := before
+	after.Symbol = req.Symbol
+	after.Quantity = req.Quantity
+	after.Price = req.Price
+	after.touch(ctx, now)
+	s.recordChange(ctx, &before, &after)
+	return after.toProto(), nil
 }
 
+// DeleteAsset moves the asset to the trash. It can be brought back with
+// RestoreAsset until the purge job removes it.
 func (s *server) DeleteAsset(ctx context.Context, req *asset.DeleteAssetRequest) (*asset.Empty, error) {
-    assetCollection := s.mongoClient.Database("assetdb").Collection("assets")
-    objID, err := primitive.ObjectIDFromHex(req.Id)
-    if err != nil {
-        return nil, err
-    }
-    _, err = assetCollection.DeleteOne(ctx, bson.M{"_id": objID})
-    if err != nil {
-        return nil, err
-    }
-    return &asset.Empty{}, nil
+	assetCollection := s.assets
+	objID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, grpcerr.InvalidID("id", req.Id)
+	}
+	now := writeTime()
+	var before assetDocument
+	filter := versionFilter(ctx, objID, req.Version)
+	err = assetCollection.FindOneAndUpdate(ctx, filter, trashUpdate(ctx, now)).Decode(&before)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.writeConflict(ctx, assetCollection, filter)
+	}
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", req.Id)
+	}
+	after := before
+	after.DeletedAt = &now
+	after.touch(ctx, now)
+	s.recordChange(ctx, &before, &after)
+	return &asset.Empty{}, nil
+}
+
+func (s *server) ListAssets(ctx context.Context, req *asset.ListAssetsRequest) (*asset.AssetList, error) {
+	if req.AsOf != nil {
+		return s.listAssetsAsOf(ctx, req.AsOf.AsTime(), req.OrderBy)
+	}
+	assetCollection := s.assets
+	opts := options.Find()
+	if req.OrderBy != "" {
+		opts.SetSort(assetSort(req.OrderBy))
+	}
+	cursor, err := assetCollection.Find(ctx, readable(ctx, bson.M{"deleted_at": nil}), opts)
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", "")
+	}
+	defer cursor.Close(ctx)
+	var assets []*asset.Asset
+	for cursor.Next(ctx) {
+		var assetDB assetDocument
+		err := cursor.Decode(&assetDB)
+		if err != nil {
+			return nil, grpcerr.FromMongo(err, "asset", "")
+		}
+		assets = append(assets, assetDB.toProto())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", "")
+	}
+	return &asset.AssetList{Assets: assets}, nil
 }
 
-func (s *server) ListAssets(ctx context.Context, _ *asset.Empty) (*asset.AssetList, error) {
-  assetCollection := s.mongoClient.Database("assetdb").Collection("assets")
-  cursor, err := assetCollection.Find(ctx, bson.M{})
-  if err != nil {
-      return nil, err
-  }
-  defer cursor.Close(ctx)
-  var assets []*asset.Asset
-  for cursor.Next(ctx) {
-      var assetDB struct {
-          ID       primitive.ObjectID `bson:"_id"`
-          Symbol   string             `bson:"symbol"`
-          Quantity int32              `bson:"quantity"`
-          Price    float64            `bson:"price"`
-      }
-      err := cursor.Decode(&assetDB)
-      if err != nil {
-          return nil, err
-      }
-      asset := &asset.Asset{
-          Id:       assetDB.ID.Hex(),
-          Symbol:   assetDB.Symbol,
-          Quantity: assetDB.Quantity,
-          Price:    assetDB.Price,
-      }
-      assets = append(assets, asset)
-  }
-  return &asset.AssetList{Assets: assets}, nil
+// fatal logs why the server cannot run and exits.
+func fatal(msg string, err error) {
+	slog.Error(msg, "err", err)
+	os.Exit(1)
 }
 
 func main() {
-  mongoClient, err := mongodb.NewClient(mongoURI)
-  if err != nil {
-    log.Fatalf("Failed to connect to MongoDB: %v", err)
-  }
-  defer mongoClient.Disconnect(context.Background())
-
-  lis, err := net.Listen("tcp", port)
-  if err != nil {
-    log.Fatalf("Failed to listen: %v", err)
-  }
-
-  s := grpc.NewServer()
-  asset.RegisterAssetServiceServer(s, &server{mongoClient: mongoClient})
-
-  log.Printf("Server listening at %v", lis.Addr())
-  if err := s.Serve(lis); err != nil {
-    log.Fatalf("Failed to serve: %v", err)
-  }
-} 
\ No newline at end of file
+	cfg, opts, err := config.Load(os.Args[1:])
+	if err == flag.ErrHelp {
+		os.Exit(0)
+	}
+	if err != nil {
+		fatal("Invalid configuration", err)
+	}
+	if opts.PrintConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			fatal("Failed to print configuration", err)
+		}
+		return
+	}
+	slog.SetDefault(cfg.Logger())
+
+	// Tokens are verified with the configured keys; the configuration is
+	// invalid without any.
+	authConfig := auth.Config{
+		HMACSecret: []byte(cfg.Auth.HMACSecret),
+		JWKSFile:   cfg.Auth.JWKSFile,
+		Issuer:     cfg.Auth.Issuer,
+		Audience:   cfg.Auth.Audience,
+	}
+	verifier, err := auth.NewVerifier(authConfig)
+	if err != nil {
+		fatal("Failed to set up authentication", err)
+	}
+	authenticator := &auth.Authenticator{Tokens: verifier}
+	if cfg.TLS.ClientCertsFile != "" {
+		certs, err := auth.LoadCertificates(cfg.TLS.ClientCertsFile)
+		if err != nil {
+			fatal("Failed to load client certificates", err)
+		}
+		authenticator.Certificates = certs
+	}
+
+	// Background jobs run until shutdown, which waits for them to finish.
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	var jobs sync.WaitGroup
+	runJob := func(job func(ctx context.Context)) {
+		jobs.Add(1)
+		go func() {
+			defer jobs.Done()
+			job(jobsCtx)
+		}()
+	}
+
+	// The server speaks TLS when given a certificate and verifies client
+	// certificates when given a client CA.
+	var tlsConfig *tls.Config
+	if cfg.TLS.CertFile != "" {
+		reloader, err := tlsreload.New(tlsreload.Files{
+			CertFile:          cfg.TLS.CertFile,
+			KeyFile:           cfg.TLS.KeyFile,
+			ClientCAFile:      cfg.TLS.ClientCAFile,
+			RequireClientCert: cfg.TLS.RequireClientCert,
+		})
+		if err != nil {
+			fatal("Failed to load TLS certificates", err)
+		}
+		runJob(func(ctx context.Context) { reloader.Run(ctx, cfg.TLS.ReloadInterval) })
+		tlsConfig = reloader.Config()
+	}
+
+	serverMetrics := metrics.New()
+	mongoClient, err := mongodb.NewClient(mongodb.ClientOptions{
+		URI:                    cfg.Mongo.URI,
+		ConnectTimeout:         cfg.Mongo.ConnectTimeout,
+		ServerSelectionTimeout: cfg.Mongo.ServerSelectionTimeout,
+		SocketTimeout:          cfg.Mongo.SocketTimeout,
+		MinPoolSize:            cfg.Mongo.MinPoolSize,
+		MaxPoolSize:            cfg.Mongo.MaxPoolSize,
+		ReadConcern:            cfg.Mongo.ReadConcern,
+		WriteConcern:           cfg.Mongo.WriteConcern,
+		Monitor:                serverMetrics.CommandMonitor(),
+		PoolMonitor:            serverMetrics.PoolMonitor(),
+	})
+	if err != nil {
+		fatal("Failed to connect to MongoDB", err)
+	}
+	// Fail early on a wrong URI or credentials instead of on the first
+	// request, but give a database that is still starting time to come up.
+	startupCtx, cancelStartup := context.WithTimeout(context.Background(), cfg.Mongo.StartupTimeout)
+	err = mongodb.WaitReachable(startupCtx, mongoClient)
+	cancelStartup()
+	if err != nil {
+		fatal("Failed to reach MongoDB", err)
+	}
+
+	db := mongoClient.Database(cfg.Mongo.Database)
+	collections := cfg.Mongo.Collections
+	assetCollection := db.Collection(collections.Assets)
+	if err := mongodb.EnsureAssetIndexes(context.Background(), assetCollection); err != nil {
+		fatal("Failed to create asset indexes", err)
+	}
+	auditCollection := db.Collection(collections.AuditEvents)
+	if err := mongodb.EnsureAuditIndexes(context.Background(), auditCollection); err != nil {
+		fatal("Failed to create audit indexes", err)
+	}
+	revisionCollection := db.Collection(collections.Revisions)
+	if err := mongodb.EnsureRevisionIndexes(context.Background(), revisionCollection); err != nil {
+		fatal("Failed to create revision indexes", err)
+	}
+	// Assets created before they had owners can be handed to a user.
+	if owner := cfg.Mongo.LegacyOwner; owner != "" {
+		if err := mongodb.ClaimUnowned(context.Background(), owner, assetCollection, revisionCollection); err != nil {
+			fatal("Failed to assign unowned assets", err)
+		}
+	}
+	userCollection := db.Collection(collections.Users)
+	refreshTokenCollection := db.Collection(collections.RefreshTokens)
+	if err := mongodb.EnsureUserIndexes(context.Background(), userCollection, refreshTokenCollection); err != nil {
+		fatal("Failed to create user indexes", err)
+	}
+	shareCollection := db.Collection(collections.Shares)
+	if err := mongodb.EnsureShareIndexes(context.Background(), shareCollection); err != nil {
+		fatal("Failed to create share indexes", err)
+	}
+	shares := sharing.NewStore(shareCollection)
+	apiKeyCollection := db.Collection(collections.APIKeys)
+	if err := mongodb.EnsureAPIKeyIndexes(context.Background(), apiKeyCollection); err != nil {
+		fatal("Failed to create API key indexes", err)
+	}
+	keys := apikeys.NewStore(apiKeyCollection)
+	authenticator.APIKeys = keys
+	srv := &server{
+		mongoClient: mongoClient,
+		assets:      assetCollection,
+		audit:       audit.NewRecorder(auditCollection, cfg.Mongo.LegacyOwner),
+		history:     history.NewStore(revisionCollection),
+	}
+	// A failed backfill only leaves old assets out of reads of the past and
+	// is retried on the next start, so it does not hold this one up.
+	backfillCtx, cancelBackfill := context.WithTimeout(context.Background(), backfillTimeout)
+	err = srv.backfillHistory(backfillCtx)
+	cancelBackfill()
+	if err != nil {
+		slog.Warn("Failed to backfill asset history", "err", err)
+	}
+
+	runJob(func(ctx context.Context) { srv.runPurgeJob(ctx, cfg.Trash.Retention, cfg.Trash.PurgeInterval) })
+	runJob(func(ctx context.Context) { srv.runPortfolioMetrics(ctx, serverMetrics, cfg.Metrics.PortfolioInterval) })
+
+	lis, err := net.Listen("tcp", cfg.Listen)
+	if err != nil {
+		fatal("Failed to listen", err)
+	}
+	httpLis, err := net.Listen("tcp", cfg.HTTPListen)
+	if err != nil {
+		fatal("Failed to listen for HTTP", err)
+	}
+	methodDeadlines := make(map[string]deadline.Limits, len(cfg.Deadlines.Methods))
+	for method, d := range cfg.Deadlines.Methods {
+		methodDeadlines[method] = deadline.Limits{Default: d.Default, Max: d.Max}
+	}
+	streams := newStreamEnder()
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			serverMetrics.UnaryServerInterceptor(),
+			requestid.UnaryServerInterceptor(),
+			// Bound the calls before any interceptor uses the database.
+			deadline.UnaryServerInterceptor(deadline.Limits{Default: cfg.Deadlines.Default, Max: cfg.Deadlines.Max}, methodDeadlines),
+			grpcerr.UnaryServerInterceptor(),
+			auth.UnaryServerInterceptor(authenticator, publicMethods...),
+			rbac.UnaryServerInterceptor(publicMethods...),
+			sharing.UnaryServerInterceptor(shares),
+			validate.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			streams.StreamServerInterceptor(),
+			serverMetrics.StreamServerInterceptor(),
+			grpcerr.StreamServerInterceptor(),
+			auth.StreamServerInterceptor(authenticator, publicMethods...),
+			rbac.StreamServerInterceptor(publicMethods...),
+		),
+	}
+	if tlsConfig != nil {
+		serverOptions = append(serverOptions, grpc.Creds(serverCredentials{credentials.NewTLS(tlsConfig)}))
+	}
+	s := grpc.NewServer(serverOptions...)
+	asset.RegisterAssetServiceServer(s, srv)
+	sharingSrv := &sharingServer{shares: shares}
+	asset.RegisterSharingServiceServer(s, sharingSrv)
+	asset.RegisterApiKeyServiceServer(s, &apiKeyServer{keys: keys})
+	// Accounts are only served when the server can sign its own tokens;
+	// deployments verifying tokens of an external provider with a JWKS
+	// file manage users there.
+	if issuer, err := auth.NewIssuer(authConfig, cfg.Auth.AccessTokenTTL); err == nil {
+		userStore := users.NewStore(userCollection, refreshTokenCollection)
+		// Keys of demoted users lose the roles they no longer have.
+		keys.Owners = userStore
+		sharingSrv.users = userStore
+		asset.RegisterAuthServiceServer(s, &authServer{
+			users:             userStore,
+			issuer:            issuer,
+			refreshTTL:        cfg.Auth.RefreshTokenTTL,
+			allowRegistration: cfg.Auth.AllowRegistration,
+		})
+	} else {
+		slog.Info("Not serving AuthService", "reason", err)
+	}
+
+	// Every service registered so far needs the database, so they all
+	// share its health.
+	var services []string
+	for name := range s.GetServiceInfo() {
+		services = append(services, name)
+	}
+	checker := health.NewChecker(mongoClient, services...)
+	healthpb.RegisterHealthServer(s, checker.Server())
+	runJob(func(ctx context.Context) { checker.Run(ctx, cfg.Health.CheckInterval, cfg.Health.CheckTimeout) })
+	reflection.Register(s)
+
+	gateway, err := newGateway(context.Background(), s)
+	if err != nil {
+		fatal("Failed to set up REST gateway", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/v1/", gateway)
+	mux.Handle("GET /openapi.json", openapi.Handler())
+	// Binaries built with the embedfrontend tag serve the web frontend from
+	// the remaining paths.
+	if dist, ok := frontend.Dist(); ok {
+		web, err := spa.Handler(dist)
+		if err != nil {
+			fatal("Failed to serve frontend", err)
+		}
+		mux.Handle("/", web)
+	}
+
+	// Native gRPC clients get a listener of their own, served by the gRPC
+	// server itself. Browsers and REST clients use the HTTP listener.
+	handler := newHTTPHandler(s, cfg.CORS.AllowedOrigins, mux)
+	httpServer := &http.Server{Handler: handler, TLSConfig: tlsConfig}
+	serveErr := make(chan error, 3)
+	go func() { serveErr <- s.Serve(lis) }()
+	go func() {
+		if tlsConfig != nil {
+			serveErr <- httpServer.ServeTLS(httpLis, "", "")
+		} else {
+			serveErr <- httpServer.Serve(httpLis)
+		}
+	}()
+	slog.Info("Server listening", "grpc", lis.Addr().String(), "http", httpLis.Addr().String())
+
+	// Metrics are kept off the public listener; they need no credentials
+	// and reveal the value of all portfolios.
+	var metricsServer *http.Server
+	if cfg.Metrics.Listen != "" {
+		metricsLis, err := net.Listen("tcp", cfg.Metrics.Listen)
+		if err != nil {
+			fatal("Failed to listen for metrics", err)
+		}
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("GET /metrics", serverMetrics.Handler())
+		metricsServer = &http.Server{Handler: metricsMux}
+		go func() { serveErr <- metricsServer.Serve(metricsLis) }()
+		slog.Info("Serving metrics", "address", metricsLis.Addr().String())
+	}
+
+	signalCtx, stopSignals := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	select {
+	case err := <-serveErr:
+		fatal("Failed to serve", err)
+	case <-signalCtx.Done():
+	}
+	// A second signal kills the server at once.
+	stopSignals()
+
+	slog.Info("Shutting down, waiting for running requests", "timeout", cfg.ShutdownTimeout)
+	checker.Shutdown()
+	// Watchers have been told the server is going away; their streams
+	// would otherwise keep the connections busy until the timeout.
+	streams.end()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	// HTTP requests are drained first: the gRPC server cannot drain the
+	// gRPC-Web calls it serves through the wrapper, only its own
+	// connections.
+	httpErr := httpServer.Shutdown(shutdownCtx)
+	if metricsServer != nil {
+		if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+			slog.Warn("Failed to close metrics connections", "err", err)
+		}
+	}
+	stopped := make(chan struct{})
+	if httpErr == nil {
+		go func() {
+			s.GracefulStop()
+			close(stopped)
+		}()
+	}
+	select {
+	case <-stopped:
+	case <-shutdownCtx.Done():
+		slog.Warn("Canceling requests still running", "timeout", cfg.ShutdownTimeout)
+		s.Stop()
+	}
+	stopJobs()
+	jobs.Wait()
+	disconnectCtx, cancel := context.WithTimeout(context.Background(), disconnectTimeout)
+	defer cancel()
+	if err := mongoClient.Disconnect(disconnectCtx); err != nil {
+		slog.Warn("Failed to disconnect from MongoDB", "err", err)
+	}
+	slog.Info("Server stopped")
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMergePosition(t *testing.T) {
+	tests := []struct {
+		name                  string
+		oldQuantity, quantity int32
+		oldPrice, price       float64
+		wantQuantity          int32
+		wantPrice             float64
+		wantCode              codes.Code
+	}{
+		{name: "weighted average", oldQuantity: 10, oldPrice: 100, quantity: 30, price: 200, wantQuantity: 40, wantPrice: 175},
+		{name: "same price", oldQuantity: 5, oldPrice: 42, quantity: 5, price: 42, wantQuantity: 10, wantPrice: 42},
+		{name: "empty position", oldQuantity: 0, oldPrice: 0, quantity: 3, price: 9.5, wantQuantity: 3, wantPrice: 9.5},
+		{name: "adding nothing", oldQuantity: 4, oldPrice: 12, quantity: 0, price: 99, wantQuantity: 4, wantPrice: 12},
+		{name: "both empty", oldQuantity: 0, oldPrice: 7, quantity: 0, price: 8, wantQuantity: 0, wantPrice: 8},
+		{name: "up to the maximum", oldQuantity: math.MaxInt32 - 1, oldPrice: 1, quantity: 1, price: 1, wantQuantity: math.MaxInt32, wantPrice: 1},
+		{name: "overflow", oldQuantity: math.MaxInt32, oldPrice: 1, quantity: 1, price: 1, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quantity, price, err := mergePosition(tt.oldQuantity, tt.oldPrice, tt.quantity, tt.price)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("mergePosition() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			if quantity != tt.wantQuantity || math.Abs(price-tt.wantPrice) > 1e-9 {
+				t.Errorf("mergePosition() = %d, %v, want %d, %v", quantity, price, tt.wantQuantity, tt.wantPrice)
+			}
+		})
+	}
+}
+
+func TestRegisterClosed(t *testing.T) {
+	srv := &authServer{allowRegistration: false}
+	_, err := srv.Register(context.Background(), &asset.RegisterRequest{Username: "alice", Password: "correct horse"})
+	if code := status.Code(err); code != codes.PermissionDenied {
+		t.Errorf("Register() error = %v, want code %v", err, codes.PermissionDenied)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// runPortfolioMetrics updates the portfolio gauges of m every interval
+// until ctx is canceled. They scan every live asset, so they are computed
+// in the background rather than on each scrape, and each update must finish
+// before the next is due.
+func (s *server) runPortfolioMetrics(ctx context.Context, m *metrics.Metrics, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.updatePortfolioMetrics(ctx, m, interval); err != nil && ctx.Err() == nil {
+			slog.Warn("Failed to update portfolio metrics", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *server) updatePortfolioMetrics(ctx context.Context, m *metrics.Metrics, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	cursor, err := s.assets.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"deleted_at": nil}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":    nil,
+			"assets": bson.M{"$sum": 1},
+			"value":  bson.M{"$sum": bson.M{"$multiply": bson.A{"$quantity", "$price"}}},
+		}}},
+	})
+	if err != nil {
+		return err
+	}
+	var totals []struct {
+		Assets int64   `bson:"assets"`
+		Value  float64 `bson:"value"`
+	}
+	if err := cursor.All(ctx, &totals); err != nil {
+		return err
+	}
+	// Without any asset the group stage outputs nothing.
+	if len(totals) == 0 {
+		m.SetPortfolios(0, 0)
+		return nil
+	}
+	m.SetPortfolios(totals[0].Assets, totals[0].Value)
+	slog.Debug("Updated portfolio metrics", "assets", totals[0].Assets, "value", totals[0].Value)
+	return nil
+}
@@ -0,0 +1,188 @@
+// Package metrics exports Prometheus metrics about the RPCs the server
+// handles, the MongoDB commands and connection pools it uses and the
+// portfolios it stores.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.mongodb.org/mongo-driver/event"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Metrics holds the metrics of a server in a registry of its own.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcStarted  *prometheus.CounterVec
+	rpcHandled  *prometheus.CounterVec
+	rpcDuration *prometheus.HistogramVec
+
+	commandDuration   *prometheus.HistogramVec
+	poolConnections   *prometheus.GaugeVec
+	poolInUse         *prometheus.GaugeVec
+	poolCheckoutFails *prometheus.CounterVec
+
+	assets         prometheus.Gauge
+	portfolioValue prometheus.Gauge
+}
+
+// New registers the metrics, along with those of the Go runtime and the
+// process.
+func New() *Metrics {
+	rpcLabels := []string{"grpc_type", "grpc_service", "grpc_method"}
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcStarted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_started_total",
+			Help: "RPCs started on the server.",
+		}, rpcLabels),
+		rpcHandled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "RPCs completed on the server, by status code.",
+		}, append(rpcLabels, "grpc_code")),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Time taken to handle RPCs.",
+			Buckets: prometheus.DefBuckets,
+		}, rpcLabels),
+		commandDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "mongodb_command_duration_seconds",
+			Help:    "Time taken by MongoDB commands, by outcome.",
+			Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+		}, []string{"command", "outcome"}),
+		poolConnections: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "mongodb_pool_connections",
+			Help: "Open connections in the pool of each MongoDB server.",
+		}, []string{"server"}),
+		poolInUse: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "mongodb_pool_connections_in_use",
+			Help: "Connections checked out of the pool of each MongoDB server.",
+		}, []string{"server"}),
+		poolCheckoutFails: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mongodb_pool_checkout_failures_total",
+			Help: "Failed attempts to check a connection out of the pool of each MongoDB server.",
+		}, []string{"server"}),
+		assets: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "portfolio_assets",
+			Help: "Assets held in all portfolios, not counting the trash.",
+		}),
+		portfolioValue: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "portfolio_value",
+			Help: "Total value of the assets held in all portfolios, not counting the trash.",
+		}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcStarted, m.rpcHandled, m.rpcDuration,
+		m.commandDuration, m.poolConnections, m.poolInUse, m.poolCheckoutFails,
+		m.assets, m.portfolioValue,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// UnaryServerInterceptor counts and times unary RPCs. It should come first
+// in the chain so that calls rejected by other interceptors are counted.
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		done := m.startRPC("unary", info.FullMethod)
+		resp, err := handler(ctx, req)
+		done(err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor.
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		rpcType := "server_stream"
+		switch {
+		case info.IsClientStream && info.IsServerStream:
+			rpcType = "bidi_stream"
+		case info.IsClientStream:
+			rpcType = "client_stream"
+		}
+		done := m.startRPC(rpcType, info.FullMethod)
+		err := handler(srv, ss)
+		done(err)
+		return err
+	}
+}
+
+// startRPC records the start of an RPC and returns the function recording
+// its end.
+func (m *Metrics) startRPC(rpcType, fullMethod string) func(error) {
+	service, method := splitMethod(fullMethod)
+	m.rpcStarted.WithLabelValues(rpcType, service, method).Inc()
+	start := time.Now()
+	return func(err error) {
+		m.rpcDuration.WithLabelValues(rpcType, service, method).Observe(time.Since(start).Seconds())
+		m.rpcHandled.WithLabelValues(rpcType, service, method, status.Code(err).String()).Inc()
+	}
+}
+
+// splitMethod splits /package.Service/Method into its service and method.
+func splitMethod(fullMethod string) (service, method string) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "unknown", "unknown"
+	}
+	return service, method
+}
+
+// CommandMonitor times the commands of a MongoDB client.
+func (m *Metrics) CommandMonitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			m.commandDuration.WithLabelValues(e.CommandName, "success").Observe(e.Duration.Seconds())
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			m.commandDuration.WithLabelValues(e.CommandName, "failure").Observe(e.Duration.Seconds())
+		},
+	}
+}
+
+// PoolMonitor tracks the connection pools of a MongoDB client.
+func (m *Metrics) PoolMonitor() *event.PoolMonitor {
+	return &event.PoolMonitor{
+		Event: func(e *event.PoolEvent) {
+			switch e.Type {
+			case event.ConnectionCreated:
+				m.poolConnections.WithLabelValues(e.Address).Inc()
+			case event.ConnectionClosed:
+				m.poolConnections.WithLabelValues(e.Address).Dec()
+			case event.GetSucceeded:
+				m.poolInUse.WithLabelValues(e.Address).Inc()
+			case event.ConnectionReturned:
+				m.poolInUse.WithLabelValues(e.Address).Dec()
+			case event.GetFailed:
+				m.poolCheckoutFails.WithLabelValues(e.Address).Inc()
+			case event.PoolClosedEvent:
+				// The server left the deployment or the client disconnected.
+				m.poolConnections.DeleteLabelValues(e.Address)
+				m.poolInUse.DeleteLabelValues(e.Address)
+			}
+		},
+	}
+}
+
+// SetPortfolios reports the number and total value of the assets held in
+// all portfolios.
+func (m *Metrics) SetPortfolios(assets int64, value float64) {
+	m.assets.Set(float64(assets))
+	m.portfolioValue.Set(value)
+}
@@ -1,16 +1,342 @@
 package mongodb
 
 import (
-  "context"
-  "go.mongodb.org/mongo-driver/mongo"
-  "go.mongodb.org/mongo-driver/mongo/options"
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+)
+
+// SymbolCollation compares symbols case-insensitively. Queries on symbol
+// must use it to be served by the unique symbol index.
+var SymbolCollation = &options.Collation{Locale: "en", Strength: 2}
+
+// Defaults of the options NewClient applies when neither ClientOptions nor
+// the URI set them. Other options keep the defaults of the driver.
+const (
+	defaultConnectTimeout         = 10 * time.Second
+	defaultServerSelectionTimeout = 10 * time.Second
+	defaultWriteConcern           = "majority"
+)
+
+// ClientOptions tune the connection to the deployment. Settings given here
+// take precedence over the same options in the URI; zero values leave them
+// to the URI.
+type ClientOptions struct {
+	URI                    string
+	ConnectTimeout         time.Duration
+	ServerSelectionTimeout time.Duration
+	// SocketTimeout bounds every socket read and write.
+	SocketTimeout time.Duration
+	MinPoolSize   uint64
+	// MaxPoolSize limits the connections per server.
+	MaxPoolSize uint64
+	// ReadConcern is a read concern level such as local or majority.
+	ReadConcern string
+	// WriteConcern is majority or the number of members that must
+	// acknowledge writes.
+	WriteConcern string
+	// Monitor and PoolMonitor, if set, observe the commands and connection
+	// pools of the client.
+	Monitor     *event.CommandMonitor
+	PoolMonitor *event.PoolMonitor
+}
+
+// NewClient prepares a client for the deployment described by opts. It does
+// not wait for the deployment to answer; see WaitReachable.
+func NewClient(opts ClientOptions) (*mongo.Client, error) {
+	clientOptions := options.Client().ApplyURI(opts.URI).
+		SetMonitor(opts.Monitor).
+		SetPoolMonitor(opts.PoolMonitor)
+	switch {
+	case opts.ConnectTimeout > 0:
+		clientOptions.SetConnectTimeout(opts.ConnectTimeout)
+	case clientOptions.ConnectTimeout == nil:
+		clientOptions.SetConnectTimeout(defaultConnectTimeout)
+	}
+	switch {
+	case opts.ServerSelectionTimeout > 0:
+		clientOptions.SetServerSelectionTimeout(opts.ServerSelectionTimeout)
+	case clientOptions.ServerSelectionTimeout == nil:
+		clientOptions.SetServerSelectionTimeout(defaultServerSelectionTimeout)
+	}
+	if opts.SocketTimeout > 0 {
+		clientOptions.SetSocketTimeout(opts.SocketTimeout)
+	}
+	if opts.MinPoolSize > 0 {
+		clientOptions.SetMinPoolSize(opts.MinPoolSize)
+	}
+	if opts.MaxPoolSize > 0 {
+		clientOptions.SetMaxPoolSize(opts.MaxPoolSize)
+	}
+	if opts.ReadConcern != "" {
+		clientOptions.SetReadConcern(readconcern.New(readconcern.Level(opts.ReadConcern)))
+	}
+	w := opts.WriteConcern
+	if w == "" && clientOptions.WriteConcern == nil {
+		w = defaultWriteConcern
+	}
+	if w != "" {
+		writeConcern, err := parseWriteConcern(w)
+		if err != nil {
+			return nil, err
+		}
+		clientOptions.SetWriteConcern(writeConcern)
+	}
+	return mongo.Connect(context.Background(), clientOptions)
+}
+
+func parseWriteConcern(s string) (*writeconcern.WriteConcern, error) {
+	if s == "majority" {
+		return writeconcern.Majority(), nil
+	}
+	w, err := strconv.Atoi(s)
+	if err != nil || w < 0 {
+		return nil, fmt.Errorf("write concern %q is neither majority nor a number of members", s)
+	}
+	return &writeconcern.WriteConcern{W: w}, nil
+}
+
+// Backoff between the pings of WaitReachable.
+const (
+	initialRetryDelay = 500 * time.Millisecond
+	maxRetryDelay     = 10 * time.Second
 )
 
-func NewClient(uri string) (*mongo.Client, error) {
-  clientOptions := options.Client().ApplyURI(uri)
-  client, err := mongo.Connect(context.Background(), clientOptions)
-  if err != nil {
-    return nil, err
-  }
-  return client, nil
-}
\ No newline at end of file
+// WaitReachable pings the primary of client until it answers, doubling the
+// delay between attempts up to maxRetryDelay. It gives up with the last
+// error once ctx is done.
+func WaitReachable(ctx context.Context, client *mongo.Client) error {
+	delay := initialRetryDelay
+	for attempt := 1; ; attempt++ {
+		err := client.Ping(ctx, readpref.Primary())
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("MongoDB unreachable after %d attempts: %w", attempt, err)
+		}
+		slog.Warn("MongoDB is unreachable, retrying", "delay", delay, "err", err)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("MongoDB unreachable after %d attempts: %w", attempt, err)
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, maxRetryDelay)
+	}
+}
+
+// EnsureAssetIndexes creates the indexes the asset handlers rely on. Every
+// handler query is scoped to an owner, so the indexes lead with it. A symbol
+// may only appear once among the live assets of an owner; trashed assets do
+// not count.
+func EnsureAssetIndexes(ctx context.Context, assets *mongo.Collection) error {
+	// The unique index only covers documents with an explicit null
+	// deleted_at, so backfill documents written before soft deletes.
+	_, err := assets.UpdateMany(ctx,
+		bson.M{"deleted_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"deleted_at": nil}},
+	)
+	if err != nil {
+		return err
+	}
+	// Documents written before timestamps were kept are dated by the
+	// creation time embedded in their ObjectID.
+	_, err = assets.UpdateMany(ctx,
+		bson.M{"created_at": bson.M{"$exists": false}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"created_at": bson.M{"$toDate": "$_id"},
+			"updated_at": bson.M{"$ifNull": bson.A{"$updated_at", bson.M{"$toDate": "$_id"}}},
+		}}}},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = assets.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "owner", Value: 1}, {Key: "symbol", Value: 1}},
+			Options: options.Index().
+				SetName("owner_symbol_live_unique").
+				SetUnique(true).
+				SetCollation(SymbolCollation).
+				SetPartialFilterExpression(bson.M{"deleted_at": bson.M{"$type": "null"}}),
+		},
+		{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "deleted_at", Value: 1}},
+			Options: options.Index().SetName("owner_deleted_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("owner_created_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "updated_at", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("owner_updated_at"),
+		},
+		// Used by the purge job, which works across owners.
+		{
+			Keys:    bson.D{{Key: "deleted_at", Value: 1}},
+			Options: options.Index().SetName("deleted_at"),
+		},
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return duplicateSymbolsError(ctx, assets, err)
+	}
+	return err
+}
+
+// maxReportedDuplicates limits how many duplicate symbols
+// duplicateSymbolsError names.
+const maxReportedDuplicates = 20
+
+// duplicateSymbolsError explains why the unique symbol index cannot be
+// built: databases written before symbols were unique may hold the same
+// symbol more than once in a portfolio. Merging such positions changes the
+// holdings, so it is left to the operator. indexErr is returned if no
+// duplicates are found.
+func duplicateSymbolsError(ctx context.Context, assets *mongo.Collection, indexErr error) error {
+	cursor, err := assets.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"deleted_at": nil}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"owner": "$owner", "symbol": "$symbol"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id.owner", Value: 1}, {Key: "_id.symbol", Value: 1}}}},
+		{{Key: "$limit", Value: maxReportedDuplicates}},
+	}, options.Aggregate().SetCollation(SymbolCollation))
+	if err != nil {
+		return err
+	}
+	var groups []struct {
+		ID struct {
+			Owner  string `bson:"owner"`
+			Symbol string `bson:"symbol"`
+		} `bson:"_id"`
+		Count int `bson:"count"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return err
+	}
+	var duplicates []string
+	for _, g := range groups {
+		duplicates = append(duplicates, fmt.Sprintf("%s held %d times by %q", g.ID.Symbol, g.Count, g.ID.Owner))
+	}
+	if len(duplicates) == 0 {
+		return indexErr
+	}
+	if len(duplicates) == maxReportedDuplicates {
+		duplicates = append(duplicates, "and possibly more")
+	}
+	return fmt.Errorf("symbols must be unique within a portfolio; merge or delete the duplicate assets and restart: %s",
+		strings.Join(duplicates, ", "))
+}
+
+// EnsureAuditIndexes creates the indexes used to page through the audit
+// events of an owner by asset and by time.
+func EnsureAuditIndexes(ctx context.Context, events *mongo.Collection) error {
+	_, err := events.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("owner_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "asset_id", Value: 1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("owner_asset_id_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "time", Value: -1}},
+			Options: options.Index().SetName("time"),
+		},
+	})
+	return err
+}
+
+// EnsureRevisionIndexes creates the indexes used to find the revisions of an
+// asset, or of all assets of an owner, in effect at a given time.
+func EnsureRevisionIndexes(ctx context.Context, revisions *mongo.Collection) error {
+	_, err := revisions.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "asset_id", Value: 1}, {Key: "version", Value: -1}, {Key: "time", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("asset_id_version_time"),
+		},
+		{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "time", Value: -1}},
+			Options: options.Index().SetName("owner_time"),
+		},
+	})
+	return err
+}
+
+// ClaimUnowned gives the assets written before they had owners, along with
+// their revisions, to owner. Without an owner they are not visible to
+// anyone. Their audit events are left as they were recorded; the audit
+// Recorder attributes them to owner when listing.
+func ClaimUnowned(ctx context.Context, owner string, assets, revisions *mongo.Collection) error {
+	// Revisions of unowned assets carry an empty owner.
+	unowned := bson.M{"owner": bson.M{"$in": bson.A{nil, ""}}}
+	if _, err := assets.UpdateMany(ctx, unowned, bson.M{"$set": bson.M{"owner": owner}}); err != nil {
+		return err
+	}
+	_, err := revisions.UpdateMany(ctx, unowned, bson.M{"$set": bson.M{"owner": owner, "document.owner": owner}})
+	return err
+}
+
+// EnsureShareIndexes allows one share per owner and grantee and finds the
+// shares granted to a user.
+func EnsureShareIndexes(ctx context.Context, shares *mongo.Collection) error {
+	_, err := shares.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "grantee", Value: 1}},
+			Options: options.Index().SetName("owner_grantee_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "grantee", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("grantee_created_at"),
+		},
+	})
+	return err
+}
+
+// EnsureAPIKeyIndexes makes key hashes unique, which also serves lookups by
+// key, and lists the keys of an owner.
+func EnsureAPIKeyIndexes(ctx context.Context, keys *mongo.Collection) error {
+	_, err := keys.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "hash", Value: 1}},
+			Options: options.Index().SetName("hash_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "owner", Value: 1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("owner_id"),
+		},
+	})
+	return err
+}
+
+// EnsureUserIndexes makes usernames unique and lets MongoDB remove expired
+// refresh tokens.
+func EnsureUserIndexes(ctx context.Context, users, refreshTokens *mongo.Collection) error {
+	_, err := users.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "username", Value: 1}},
+		Options: options.Index().SetName("username_unique").SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = refreshTokens.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(0),
+	})
+	return err
+}
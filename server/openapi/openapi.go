@@ -0,0 +1,19 @@
+// Package openapi serves the OpenAPI document of the REST mapping of
+// AssetService, generated from proto/asset.proto by protoc-gen-openapiv2.
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+//go:embed openapi.swagger.json
+var document []byte
+
+// Handler serves the document as JSON.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(document)
+	})
+}
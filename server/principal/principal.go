@@ -0,0 +1,43 @@
+// Package principal carries the identity of the caller of an RPC through its
+// context.
+package principal
+
+import "context"
+
+// Anonymous is the name reported for calls without an authenticated caller.
+const Anonymous = "anonymous"
+
+// Principal identifies an authenticated caller.
+type Principal struct {
+	// Subject uniquely identifies the caller, e.g. a user id.
+	Subject string
+	// Roles decide which methods the caller may call.
+	Roles []string
+	// Scopes, if not nil, further limit the caller to the methods they
+	// cover. Callers authenticated with an API key always have scopes.
+	Scopes []string
+	// Permissions, if not nil, further limit the caller to these rbac
+	// permissions, whatever Roles grant.
+	Permissions []string
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying p.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// FromContext returns the principal stored in ctx, if any.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(contextKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+// Name returns the subject of the caller, or Anonymous.
+func Name(ctx context.Context) string {
+	if p, ok := FromContext(ctx); ok {
+		return p.Subject
+	}
+	return Anonymous
+}
@@ -0,0 +1,193 @@
+// Package rbac decides which RPCs a caller may make based on the roles of
+// their principal. Every method maps to one permission in Policy, and every
+// role grants a fixed set of permissions. Methods missing from Policy are
+// denied.
+package rbac
+
+import (
+	"context"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/principal"
+	"google.golang.org/grpc"
+)
+
+// Role names a set of permissions.
+type Role string
+
+const (
+	Viewer Role = "viewer"
+	Editor Role = "editor"
+	Admin  Role = "admin"
+)
+
+// Permission allows a group of related methods.
+type Permission string
+
+const (
+	ReadAssets   Permission = "assets.read"
+	WriteAssets  Permission = "assets.write"
+	PurgeAssets  Permission = "assets.purge"
+	ReadAudit    Permission = "audit.read"
+	ManageUsers  Permission = "users.manage"
+	ManageShares Permission = "shares.manage"
+	ManageKeys   Permission = "apikeys.manage"
+
+	// ReadAllAudit extends ReadAudit to every portfolio. No method requires
+	// it; ListAuditEvents checks it.
+	ReadAllAudit Permission = "audit.read_all"
+)
+
+var rolePermissions = map[Role][]Permission{
+	Viewer: {ReadAssets, ManageKeys},
+	Editor: {ReadAssets, WriteAssets, ManageShares, ManageKeys, ReadAudit},
+	Admin:  {ReadAssets, WriteAssets, ManageShares, ManageKeys, ReadAudit, PurgeAssets, ReadAllAudit, ManageUsers},
+}
+
+// scopePermissions are the permissions API key scopes can cover.
+var scopePermissions = map[string]Permission{
+	"assets:read":  ReadAssets,
+	"assets:write": WriteAssets,
+}
+
+// Policy maps the full name of every method that needs authentication to
+// the permission it requires.
+var Policy = map[string]Permission{
+	asset.AssetService_GetAsset_FullMethodName:           ReadAssets,
+	asset.AssetService_ListAssets_FullMethodName:         ReadAssets,
+	asset.AssetService_ListDeletedAssets_FullMethodName:  ReadAssets,
+	asset.AssetService_CreateAsset_FullMethodName:        WriteAssets,
+	asset.AssetService_UpdateAsset_FullMethodName:        WriteAssets,
+	asset.AssetService_DeleteAsset_FullMethodName:        WriteAssets,
+	asset.AssetService_UpsertAsset_FullMethodName:        WriteAssets,
+	asset.AssetService_BatchCreateAssets_FullMethodName:  WriteAssets,
+	asset.AssetService_BatchUpdateAssets_FullMethodName:  WriteAssets,
+	asset.AssetService_BatchDeleteAssets_FullMethodName:  WriteAssets,
+	asset.AssetService_RestoreAsset_FullMethodName:       WriteAssets,
+	asset.AssetService_PurgeAsset_FullMethodName:         PurgeAssets,
+	asset.AssetService_ListAuditEvents_FullMethodName:    ReadAudit,
+	asset.AuthService_SetUserRole_FullMethodName:         ManageUsers,
+	asset.SharingService_SharePortfolio_FullMethodName:   ManageShares,
+	asset.SharingService_RevokeShare_FullMethodName:      ManageShares,
+	asset.SharingService_ListSharedWithMe_FullMethodName: ReadAssets,
+	asset.ApiKeyService_CreateApiKey_FullMethodName:      ManageKeys,
+	asset.ApiKeyService_ListApiKeys_FullMethodName:       ManageKeys,
+	asset.ApiKeyService_RevokeApiKey_FullMethodName:      ManageKeys,
+}
+
+// ValidScope reports whether scope is a known API key scope.
+func ValidScope(scope string) bool {
+	_, ok := scopePermissions[scope]
+	return ok
+}
+
+// Allowed reports whether any of roles grants perm. Unknown roles grant
+// nothing.
+func Allowed(roles []string, perm Permission) bool {
+	for _, role := range roles {
+		for _, p := range rolePermissions[Role(role)] {
+			if p == perm {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Permissions returns the permissions any of roles grants, in the order of
+// the roles, each once.
+func Permissions(roles []string) []Permission {
+	var perms []Permission
+	seen := make(map[Permission]bool)
+	for _, role := range roles {
+		for _, p := range rolePermissions[Role(role)] {
+			if !seen[p] {
+				seen[p] = true
+				perms = append(perms, p)
+			}
+		}
+	}
+	return perms
+}
+
+// Granted reports whether p may use perm: one of its roles grants it and,
+// if p is limited to some permissions, perm is among them. Scopes are not
+// considered.
+func Granted(p *principal.Principal, perm Permission) bool {
+	if !Allowed(p.Roles, perm) {
+		return false
+	}
+	if p.Permissions == nil {
+		return true
+	}
+	for _, granted := range p.Permissions {
+		if Permission(granted) == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize checks the caller of ctx against the policy of method.
+func authorize(ctx context.Context, method string) error {
+	perm, ok := Policy[method]
+	if !ok {
+		return grpcerr.PermissionDenied(method, "")
+	}
+	p, ok := principal.FromContext(ctx)
+	if !ok || !Granted(p, perm) || !inScope(p.Scopes, perm) {
+		return grpcerr.PermissionDenied(method, string(perm))
+	}
+	return nil
+}
+
+// inScope reports whether scopes cover perm. nil scopes cover everything.
+func inScope(scopes []string, perm Permission) bool {
+	if scopes == nil {
+		return true
+	}
+	for _, scope := range scopes {
+		if scopePermissions[scope] == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryServerInterceptor rejects calls the caller's roles do not permit.
+// The public methods, which are called without a principal, are let
+// through. It must run after authentication.
+func UnaryServerInterceptor(public ...string) grpc.UnaryServerInterceptor {
+	skip := methodSet(public)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !skip[info.FullMethod] {
+			if err := authorize(ctx, info.FullMethod); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor(public ...string) grpc.StreamServerInterceptor {
+	skip := methodSet(public)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !skip[info.FullMethod] {
+			if err := authorize(ss.Context(), info.FullMethod); err != nil {
+				return err
+			}
+		}
+		return handler(srv, ss)
+	}
+}
+
+func methodSet(methods []string) map[string]bool {
+	set := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		set[m] = true
+	}
+	return set
+}
@@ -0,0 +1,121 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/principal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAllowed(t *testing.T) {
+	tests := []struct {
+		roles []string
+		perm  Permission
+		want  bool
+	}{
+		{[]string{"viewer"}, ReadAssets, true},
+		{[]string{"viewer"}, WriteAssets, false},
+		{[]string{"viewer"}, ManageKeys, true},
+		{[]string{"viewer"}, ReadAudit, false},
+		{[]string{"editor"}, WriteAssets, true},
+		{[]string{"editor"}, ReadAudit, true},
+		{[]string{"editor"}, PurgeAssets, false},
+		{[]string{"editor"}, ReadAllAudit, false},
+		{[]string{"admin"}, PurgeAssets, true},
+		{[]string{"admin"}, ManageUsers, true},
+		{[]string{"admin"}, ReadAllAudit, true},
+		{[]string{"viewer", "editor"}, WriteAssets, true},
+		{[]string{"auditor"}, ReadAssets, false},
+		{nil, ReadAssets, false},
+	}
+	for _, tt := range tests {
+		if got := Allowed(tt.roles, tt.perm); got != tt.want {
+			t.Errorf("Allowed(%q, %s) = %v, want %v", tt.roles, tt.perm, got, tt.want)
+		}
+	}
+}
+
+func TestGranted(t *testing.T) {
+	tests := []struct {
+		name string
+		p    *principal.Principal
+		perm Permission
+		want bool
+	}{
+		{"role grants", &principal.Principal{Roles: []string{"editor"}}, WriteAssets, true},
+		{"role denies", &principal.Principal{Roles: []string{"viewer"}}, WriteAssets, false},
+		{"limited to the permission", &principal.Principal{Roles: []string{"editor"}, Permissions: []string{"assets.write"}}, WriteAssets, true},
+		{"limited to others", &principal.Principal{Roles: []string{"editor"}, Permissions: []string{"assets.read"}}, WriteAssets, false},
+		{"limited to nothing", &principal.Principal{Roles: []string{"editor"}, Permissions: []string{}}, ReadAssets, false},
+		{"limits do not add to the role", &principal.Principal{Roles: []string{"viewer"}, Permissions: []string{"assets.write"}}, WriteAssets, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Granted(tt.p, tt.perm); got != tt.want {
+				t.Errorf("Granted(%+v, %s) = %v, want %v", tt.p, tt.perm, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	const public = "/assets.AuthService/Login"
+	tests := []struct {
+		name   string
+		method string
+		p      *principal.Principal
+		want   codes.Code
+	}{
+		{"viewer reads", asset.AssetService_ListAssets_FullMethodName, &principal.Principal{Roles: []string{"viewer"}}, codes.OK},
+		{"viewer writes", asset.AssetService_CreateAsset_FullMethodName, &principal.Principal{Roles: []string{"viewer"}}, codes.PermissionDenied},
+		{"editor writes", asset.AssetService_CreateAsset_FullMethodName, &principal.Principal{Roles: []string{"editor"}}, codes.OK},
+		{"editor purges", asset.AssetService_PurgeAsset_FullMethodName, &principal.Principal{Roles: []string{"editor"}}, codes.PermissionDenied},
+		{"admin purges", asset.AssetService_PurgeAsset_FullMethodName, &principal.Principal{Roles: []string{"admin"}}, codes.OK},
+		{"admin sets roles", asset.AuthService_SetUserRole_FullMethodName, &principal.Principal{Roles: []string{"admin"}}, codes.OK},
+		{"editor sets roles", asset.AuthService_SetUserRole_FullMethodName, &principal.Principal{Roles: []string{"editor"}}, codes.PermissionDenied},
+		{"read scope reads", asset.AssetService_GetAsset_FullMethodName, &principal.Principal{Roles: []string{"editor"}, Scopes: []string{"assets:read"}}, codes.OK},
+		{"read scope writes", asset.AssetService_UpdateAsset_FullMethodName, &principal.Principal{Roles: []string{"editor"}, Scopes: []string{"assets:read"}}, codes.PermissionDenied},
+		{"write scope beyond the role", asset.AssetService_UpdateAsset_FullMethodName, &principal.Principal{Roles: []string{"viewer"}, Scopes: []string{"assets:write"}}, codes.PermissionDenied},
+		{"method missing from the policy", "/assets.AssetService/Unknown", &principal.Principal{Roles: []string{"admin"}}, codes.PermissionDenied},
+		{"no principal", asset.AssetService_ListAssets_FullMethodName, nil, codes.PermissionDenied},
+		{"public method without principal", public, nil, codes.OK},
+	}
+	interceptor := UnaryServerInterceptor(public)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.p != nil {
+				ctx = principal.NewContext(ctx, tt.p)
+			}
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("code = %v, want %v (err %v)", got, tt.want, err)
+			}
+		})
+	}
+}
+
+// TestPolicyCoversEveryMethod guards against methods that are denied to
+// everyone because they were added to a service but not to Policy. The
+// AuthService methods other than SetUserRole are public.
+func TestPolicyCoversEveryMethod(t *testing.T) {
+	for _, desc := range []grpc.ServiceDesc{
+		asset.AssetService_ServiceDesc,
+		asset.SharingService_ServiceDesc,
+		asset.ApiKeyService_ServiceDesc,
+	} {
+		for _, m := range desc.Methods {
+			if method := "/" + desc.ServiceName + "/" + m.MethodName; Policy[method] == "" {
+				t.Errorf("%s is missing from Policy", method)
+			}
+		}
+	}
+	if Policy[asset.AuthService_SetUserRole_FullMethodName] == "" {
+		t.Errorf("%s is missing from Policy", asset.AuthService_SetUserRole_FullMethodName)
+	}
+}
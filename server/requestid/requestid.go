@@ -0,0 +1,55 @@
+// Package requestid tags every RPC with a request id, taken from the
+// x-request-id metadata of the call or generated, and echoes it back in the
+// response headers.
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the metadata key carrying the request id.
+const MetadataKey = "x-request-id"
+
+type contextKey struct{}
+
+// FromContext returns the request id of the RPC ctx belongs to, or "" if
+// the interceptor has not run.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// UnaryServerInterceptor stores the request id in the handler context.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		id := fromMetadata(ctx)
+		if id == "" {
+			id = newID()
+		}
+		grpc.SetHeader(ctx, metadata.Pairs(MetadataKey, id))
+		return handler(context.WithValue(ctx, contextKey{}, id), req)
+	}
+}
+
+func fromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(MetadataKey)
+	if len(values) == 0 || len(values[0]) > 128 {
+		return ""
+	}
+	return values[0]
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
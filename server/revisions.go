@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/audit"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/deadline"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/history"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/rbac"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/sharing"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// recordChange records a write of an asset in the audit log and the
+// revision history. before is nil for creations and after is nil for purges,
+// which leave the history of the asset untouched. Revisions are stamped with
+// the update time and version of after, so that reading an asset as of its
+// update_time returns that revision, or a later one a concurrent writer
+// stamped with an earlier time.
+func (s *server) recordChange(ctx context.Context, before, after *assetDocument) {
+	s.recordChanges(ctx, []assetChange{{before: before, after: after}})
+}
+
+// assetChange is a write of an asset as recordChange takes it.
+type assetChange struct {
+	before, after *assetDocument
+}
+
+// recordChanges is like recordChange for the writes of a batch, which are
+// recorded with one insert into the audit log and one into the history.
+func (s *server) recordChanges(ctx context.Context, changes []assetChange) {
+	events := make([]audit.Change, 0, len(changes))
+	var writes []history.Write
+	for _, c := range changes {
+		doc := c.after
+		if doc == nil {
+			doc = c.before
+		}
+		events = append(events, audit.Change{Owner: doc.Owner, AssetID: doc.ID.Hex(), Before: c.before, After: c.after})
+		if c.after != nil {
+			writes = append(writes, history.Write{Owner: c.after.Owner, AssetID: c.after.ID, Time: c.after.UpdatedAt, Version: c.after.Version, Doc: c.after})
+		}
+	}
+	s.audit.RecordAll(ctx, events)
+	ctx, cancel := deadline.Detached(ctx)
+	defer cancel()
+	if err := s.history.AppendAll(ctx, writes); err != nil {
+		slog.Error("Failed to record revisions", "count", len(writes), "err", err)
+	}
+}
+
+// getAssetAsOf returns the asset the caller may read with the given id as it
+// was at t.
+func (s *server) getAssetAsOf(ctx context.Context, id primitive.ObjectID, t time.Time) (*asset.Asset, error) {
+	raw, err := s.history.At(ctx, sharing.Owners(ctx, rbac.ReadAssets), id, t)
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", id.Hex())
+	}
+	var doc assetDocument
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", id.Hex())
+	}
+	if doc.DeletedAt != nil {
+		return nil, grpcerr.NotFound("asset", id.Hex())
+	}
+	return doc.toProto(), nil
+}
+
+// listAssetsAsOf returns the assets the caller may read that were live at
+// t, ordered as orderBy asks.
+func (s *server) listAssetsAsOf(ctx context.Context, t time.Time, orderBy string) (*asset.AssetList, error) {
+	var sort bson.D
+	if orderBy != "" {
+		sort = assetSort(orderBy)
+	}
+	raws, err := s.history.AllAt(ctx, sharing.Owners(ctx, rbac.ReadAssets), t, sort)
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", "")
+	}
+	var assets []*asset.Asset
+	for _, raw := range raws {
+		var doc assetDocument
+		if err := bson.Unmarshal(raw, &doc); err != nil {
+			return nil, grpcerr.FromMongo(err, "asset", "")
+		}
+		if doc.DeletedAt == nil {
+			assets = append(assets, doc.toProto())
+		}
+	}
+	return &asset.AssetList{Assets: assets}, nil
+}
+
+// backfillHistory gives every asset written before revisions were kept a
+// baseline revision. The current values are the best record available, so
+// they are assumed to have held since the asset was created; trashed assets
+// additionally get a revision at their deletion time. Once every asset has
+// revisions, it finds nothing left to do.
+func (s *server) backfillHistory(ctx context.Context) error {
+	cursor, err := s.history.Unrecorded(ctx, s.assets)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	var writes []history.Write
+	for cursor.Next(ctx) {
+		var doc assetDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		live := doc
+		live.DeletedAt = nil
+		writes = append(writes, history.Write{Owner: doc.Owner, AssetID: doc.ID, Time: doc.ID.Timestamp(), Version: doc.Version, Doc: &live})
+		if doc.DeletedAt != nil {
+			writes = append(writes, history.Write{Owner: doc.Owner, AssetID: doc.ID, Time: *doc.DeletedAt, Version: doc.Version, Doc: &doc})
+		}
+		if len(writes) >= backfillBatchSize {
+			if err := s.history.AppendAll(ctx, writes); err != nil {
+				return err
+			}
+			writes = writes[:0]
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+	return s.history.AppendAll(ctx, writes)
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/principal"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/sharing"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/users"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type sharingServer struct {
+	asset.UnimplementedSharingServiceServer
+	shares *sharing.Store
+	// users, if not nil, resolves grantees to user ids.
+	users *users.Store
+}
+
+// grantee returns the user id a request names as grantee. With accounts
+// served by this server, grantees may be given by username or user id and
+// must exist; otherwise grantee is taken as the id as is.
+func (s *sharingServer) grantee(ctx context.Context, grantee string) (string, error) {
+	if s.users == nil {
+		return grantee, nil
+	}
+	user, err := s.users.Find(ctx, grantee)
+	if err != nil {
+		return "", grpcerr.FromMongo(err, "user", grantee)
+	}
+	return user.ID.Hex(), nil
+}
+
+// SharePortfolio shares the portfolio of the caller with another user.
+func (s *sharingServer) SharePortfolio(ctx context.Context, req *asset.SharePortfolioRequest) (*asset.Share, error) {
+	owner := principal.Name(ctx)
+	grantee, err := s.grantee(ctx, req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	if grantee == owner {
+		return nil, grpcerr.InvalidArgument("grantee", "cannot share a portfolio with its owner")
+	}
+	share, err := s.shares.Put(ctx, owner, grantee, req.Role, writeTime())
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "share", req.Grantee)
+	}
+	return shareToProto(share), nil
+}
+
+// RevokeShare stops sharing the portfolio of the caller with a user.
+func (s *sharingServer) RevokeShare(ctx context.Context, req *asset.RevokeShareRequest) (*asset.Empty, error) {
+	grantee, err := s.grantee(ctx, req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.shares.Delete(ctx, principal.Name(ctx), grantee); err != nil {
+		return nil, grpcerr.FromMongo(err, "share", req.Grantee)
+	}
+	return &asset.Empty{}, nil
+}
+
+// ListSharedWithMe lists the portfolios other users share with the caller.
+func (s *sharingServer) ListSharedWithMe(ctx context.Context, _ *asset.Empty) (*asset.ShareList, error) {
+	shares, err := s.shares.SharedWith(ctx, principal.Name(ctx))
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "share", "")
+	}
+	list := &asset.ShareList{}
+	for i := range shares {
+		list.Shares = append(list.Shares, shareToProto(&shares[i]))
+	}
+	return list, nil
+}
+
+func shareToProto(share *sharing.Share) *asset.Share {
+	return &asset.Share{
+		Owner:      share.Owner,
+		Grantee:    share.Grantee,
+		Role:       share.Role,
+		CreateTime: timestamppb.New(share.CreatedAt),
+	}
+}
@@ -0,0 +1,136 @@
+// Package sharing stores the portfolios users share with each other and
+// works out, for the caller of an RPC, which portfolios they may read or
+// change.
+package sharing
+
+import (
+	"context"
+	"time"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/principal"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/rbac"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+)
+
+// Share grants Grantee Role on the portfolio of Owner. Role is an rbac role
+// and limits what the grantee may do with the portfolio, on top of their own
+// roles.
+type Share struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Owner     string             `bson:"owner"`
+	Grantee   string             `bson:"grantee"`
+	Role      string             `bson:"role"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// Store reads and writes shares.
+type Store struct {
+	shares *mongo.Collection
+}
+
+func NewStore(shares *mongo.Collection) *Store {
+	return &Store{shares: shares}
+}
+
+// Put shares the portfolio of owner with grantee, replacing the role of an
+// existing share, and returns the share.
+func (s *Store) Put(ctx context.Context, owner, grantee, role string, now time.Time) (*Share, error) {
+	var share Share
+	err := s.shares.FindOneAndUpdate(ctx,
+		bson.M{"owner": owner, "grantee": grantee},
+		bson.M{
+			"$set":         bson.M{"role": role},
+			"$setOnInsert": bson.M{"created_at": now},
+		},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&share)
+	if err != nil {
+		return nil, err
+	}
+	return &share, nil
+}
+
+// Delete revokes the share of the portfolio of owner with grantee. It
+// returns mongo.ErrNoDocuments if there is none.
+func (s *Store) Delete(ctx context.Context, owner, grantee string) error {
+	res, err := s.shares.DeleteOne(ctx, bson.M{"owner": owner, "grantee": grantee})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// SharedWith returns the shares granted to grantee, oldest first.
+func (s *Store) SharedWith(ctx context.Context, grantee string) ([]Share, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := s.shares.Find(ctx, bson.M{"grantee": grantee}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var shares []Share
+	if err := cursor.All(ctx, &shares); err != nil {
+		return nil, err
+	}
+	return shares, nil
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the shares granted to its
+// caller.
+func NewContext(ctx context.Context, shares []Share) context.Context {
+	return context.WithValue(ctx, contextKey{}, shares)
+}
+
+// FromContext returns the shares granted to the caller of ctx.
+func FromContext(ctx context.Context) []Share {
+	shares, _ := ctx.Value(contextKey{}).([]Share)
+	return shares
+}
+
+// Owners returns the owners of the portfolios the caller of ctx may use with
+// perm: their own, and those shared with them with a role granting perm.
+func Owners(ctx context.Context, perm rbac.Permission) []string {
+	owners := []string{principal.Name(ctx)}
+	for _, share := range FromContext(ctx) {
+		if rbac.Allowed([]string{share.Role}, perm) {
+			owners = append(owners, share.Owner)
+		}
+	}
+	return owners
+}
+
+// Allowed reports whether the caller of ctx may use the portfolio of owner
+// with perm.
+func Allowed(ctx context.Context, owner string, perm rbac.Permission) bool {
+	for _, o := range Owners(ctx, perm) {
+		if o == owner {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryServerInterceptor loads the shares granted to the authenticated
+// caller into the handler context. Calls without a caller are passed on
+// unchanged.
+func UnaryServerInterceptor(s *Store) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		p, ok := principal.FromContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+		shares, err := s.SharedWith(ctx, p.Subject)
+		if err != nil {
+			return nil, err
+		}
+		return handler(NewContext(ctx, shares), req)
+	}
+}
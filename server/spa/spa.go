@@ -0,0 +1,76 @@
+// Package spa serves a single-page application from a file system. Paths
+// that match no file get the index page, so that the client-side router can
+// handle them.
+package spa
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const index = "index.html"
+
+// hashedName matches file names containing a content hash, like
+// app.3f2a9c1d.js. Their content never changes, so they are cached for good.
+var hashedName = regexp.MustCompile(`\.[0-9a-f]{8,}\.`)
+
+type file struct {
+	content []byte
+	etag    string
+}
+
+// Handler serves the files of fsys, which must contain index.html.
+func Handler(fsys fs.FS) (http.Handler, error) {
+	files := make(map[string]*file)
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(content)
+		files[name] = &file{content: content, etag: `"` + hex.EncodeToString(sum[:8]) + `"`}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if files[index] == nil {
+		return nil, fs.ErrNotExist
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		name := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+		f, ok := files[name]
+		switch {
+		case ok && hashedName.MatchString(path.Base(name)):
+			w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		case !ok && path.Ext(name) != "":
+			// Missing assets are errors, not routes of the application.
+			http.NotFound(w, r)
+			return
+		case !ok:
+			name, f = index, files[index]
+			fallthrough
+		default:
+			// Unhashed files, the index page above all, must be revalidated
+			// so that new releases are picked up.
+			w.Header().Set("Cache-Control", "no-cache")
+		}
+		w.Header().Set("ETag", f.etag)
+		http.ServeContent(w, r, name, time.Time{}, bytes.NewReader(f.content))
+	}), nil
+}
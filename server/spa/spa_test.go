@@ -0,0 +1,89 @@
+package spa
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+)
+
+func TestHandler(t *testing.T) {
+	fsys := fstest.MapFS{
+		"index.html":             {Data: []byte("<html>app</html>")},
+		"favicon.ico":            {Data: []byte("icon")},
+		"js/app.3f2a9c1d.js":     {Data: []byte("console.log('app')")},
+		"css/app.0badc0ffee.css": {Data: []byte("body{}")},
+	}
+	handler, err := Handler(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const (
+		immutable = "public, max-age=31536000, immutable"
+		noCache   = "no-cache"
+	)
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		wantCode  int
+		wantBody  string
+		wantCache string
+	}{
+		{name: "index", path: "/", wantCode: http.StatusOK, wantBody: "<html>app</html>", wantCache: noCache},
+		{name: "hashed script", path: "/js/app.3f2a9c1d.js", wantCode: http.StatusOK, wantBody: "console.log('app')", wantCache: immutable},
+		{name: "hashed stylesheet", path: "/css/app.0badc0ffee.css", wantCode: http.StatusOK, wantBody: "body{}", wantCache: immutable},
+		{name: "unhashed file", path: "/favicon.ico", wantCode: http.StatusOK, wantBody: "icon", wantCache: noCache},
+		{name: "route of the app", path: "/portfolio/settings", wantCode: http.StatusOK, wantBody: "<html>app</html>", wantCache: noCache},
+		{name: "missing asset", path: "/js/missing.js", wantCode: http.StatusNotFound},
+		{name: "path escaping the root", path: "/../index.html", wantCode: http.StatusOK, wantBody: "<html>app</html>", wantCache: noCache},
+		{name: "head", method: http.MethodHead, path: "/", wantCode: http.StatusOK, wantCache: noCache},
+		{name: "post", method: http.MethodPost, path: "/", wantCode: http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(method, tt.path, nil))
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantCode)
+			}
+			if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", rec.Body.String(), tt.wantBody)
+			}
+			if got := rec.Header().Get("Cache-Control"); got != tt.wantCache {
+				t.Errorf("Cache-Control = %q, want %q", got, tt.wantCache)
+			}
+			if tt.wantCode == http.StatusOK && rec.Header().Get("ETag") == "" {
+				t.Error("ETag is not set")
+			}
+		})
+	}
+}
+
+func TestHandlerRevalidation(t *testing.T) {
+	handler, err := Handler(fstest.MapFS{"index.html": {Data: []byte("<html>app</html>")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	etag := rec.Header().Get("ETag")
+
+	req := httptest.NewRequest(http.MethodGet, "/some/route", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotModified)
+	}
+}
+
+func TestHandlerWithoutIndex(t *testing.T) {
+	if _, err := Handler(fstest.MapFS{"app.js": {Data: []byte("")}}); err == nil {
+		t.Error("Handler() without index.html succeeded")
+	}
+}
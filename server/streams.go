@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// streamEnder ends streaming calls when the server stops. Streams such as
+// Health.Watch run for as long as the client likes, so the server does not
+// wait for them like for other calls.
+type streamEnder struct {
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+func newStreamEnder() *streamEnder {
+	return &streamEnder{closing: make(chan struct{})}
+}
+
+// StreamServerInterceptor cancels the context of every stream once end is
+// called.
+func (e *streamEnder) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := context.WithCancel(ss.Context())
+		defer cancel()
+		go func() {
+			select {
+			case <-e.closing:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		return handler(srv, &endingStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// end ends the running streams and any started later.
+func (e *streamEnder) end() {
+	e.closeOnce.Do(func() { close(e.closing) })
+}
+
+type endingStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *endingStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// trashUpdate moves a live asset to the trash on behalf of the caller of
+// ctx.
+func trashUpdate(ctx context.Context, now time.Time) bson.M {
+	return stampUpdate(ctx, now, bson.M{"deleted_at": now})
+}
+
+// trashedVersionFilter is like versionFilter but matches assets in the trash.
+func trashedVersionFilter(ctx context.Context, id primitive.ObjectID, version int64) bson.M {
+	filter := versionFilter(ctx, id, version)
+	filter["deleted_at"] = bson.M{"$ne": nil}
+	return filter
+}
+
+// ListDeletedAssets lists the assets in the trash the caller may read, most
+// recently deleted first.
+func (s *server) ListDeletedAssets(ctx context.Context, _ *asset.Empty) (*asset.AssetList, error) {
+	assetCollection := s.assets
+	opts := options.Find().SetSort(bson.D{{Key: "deleted_at", Value: -1}})
+	cursor, err := assetCollection.Find(ctx, readable(ctx, bson.M{"deleted_at": bson.M{"$ne": nil}}), opts)
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", "")
+	}
+	defer cursor.Close(ctx)
+	var assets []*asset.Asset
+	for cursor.Next(ctx) {
+		var assetDB assetDocument
+		if err := cursor.Decode(&assetDB); err != nil {
+			return nil, grpcerr.FromMongo(err, "asset", "")
+		}
+		assets = append(assets, assetDB.toProto())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", "")
+	}
+	return &asset.AssetList{Assets: assets}, nil
+}
+
+// RestoreAsset moves an asset out of the trash. It fails with AlreadyExists
+// if a live asset with the same symbol has been created in the meantime.
+func (s *server) RestoreAsset(ctx context.Context, req *asset.RestoreAssetRequest) (*asset.Asset, error) {
+	assetCollection := s.assets
+	objID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, grpcerr.InvalidID("id", req.Id)
+	}
+	now := writeTime()
+	update := stampUpdate(ctx, now, bson.M{"deleted_at": nil})
+	var before assetDocument
+	filter := trashedVersionFilter(ctx, objID, req.Version)
+	err = assetCollection.FindOneAndUpdate(ctx, filter, update).Decode(&before)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.writeConflict(ctx, assetCollection, filter)
+	}
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", req.Id)
+	}
+	after := before
+	after.DeletedAt = nil
+	after.touch(ctx, now)
+	s.recordChange(ctx, &before, &after)
+	return after.toProto(), nil
+}
+
+// PurgeAsset permanently removes an asset from the trash.
+func (s *server) PurgeAsset(ctx context.Context, req *asset.PurgeAssetRequest) (*asset.Empty, error) {
+	assetCollection := s.assets
+	objID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, grpcerr.InvalidID("id", req.Id)
+	}
+	var before assetDocument
+	filter := trashedVersionFilter(ctx, objID, req.Version)
+	err = assetCollection.FindOneAndDelete(ctx, filter).Decode(&before)
+	if err == mongo.ErrNoDocuments {
+		return nil, s.writeConflict(ctx, assetCollection, filter)
+	}
+	if err != nil {
+		return nil, grpcerr.FromMongo(err, "asset", req.Id)
+	}
+	s.recordChange(ctx, &before, nil)
+	return &asset.Empty{}, nil
+}
+
+// runPurgeJob permanently deletes assets that have been in the trash for
+// longer than retention, checking every interval until ctx is canceled.
+func (s *server) runPurgeJob(ctx context.Context, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.purgeDeletedAssets(ctx, time.Now().Add(-retention)); err != nil && ctx.Err() == nil {
+			slog.Error("Failed to purge deleted assets", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeDeletedAssets removes the assets deleted before cutoff one at a time
+// so that each removal can be audited.
+func (s *server) purgeDeletedAssets(ctx context.Context, cutoff time.Time) error {
+	assetCollection := s.assets
+	expired := bson.M{"deleted_at": bson.M{"$lt": cutoff}}
+	cursor, err := assetCollection.Find(ctx, expired, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return err
+	}
+	var ids []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &ids); err != nil {
+		return err
+	}
+	purged := 0
+	for _, id := range ids {
+		var before assetDocument
+		err := assetCollection.FindOneAndDelete(ctx, bson.M{"_id": id.ID, "deleted_at": bson.M{"$lt": cutoff}}).Decode(&before)
+		if err == mongo.ErrNoDocuments {
+			// Restored or purged since it was listed.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		s.audit.RecordJob(ctx, "purge", before.Owner, before.ID.Hex(), &before, nil)
+		purged++
+	}
+	if purged > 0 {
+		slog.Info("Purged deleted assets", "count", purged)
+	} else {
+		slog.Debug("No deleted assets to purge", "cutoff", cutoff)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+// Package users stores user accounts and the refresh tokens of their
+// sessions. Passwords are kept as bcrypt hashes and refresh tokens as SHA-256
+// hashes, so neither can be recovered from the database.
+package users
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrInvalidCredentials is returned for unknown users, wrong passwords and
+// unknown, used or expired refresh tokens alike.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// User is the stored form of an account.
+type User struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	Username     string             `bson:"username"`
+	PasswordHash []byte             `bson:"password_hash"`
+	// Role is the rbac role of the user.
+	Role      string    `bson:"role"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// refreshToken is the stored form of a refresh token.
+type refreshToken struct {
+	Hash      []byte             `bson:"_id"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+}
+
+// Store reads and writes accounts and refresh tokens.
+type Store struct {
+	users  *mongo.Collection
+	tokens *mongo.Collection
+}
+
+func NewStore(users, tokens *mongo.Collection) *Store {
+	return &Store{users: users, tokens: tokens}
+}
+
+// dummyHash is compared against when a user does not exist, so that logins
+// take as long for unknown users as for wrong passwords.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+
+// Create adds an account with the given role. It fails with a duplicate key
+// error if the username is taken and with bcrypt.ErrPasswordTooLong for
+// passwords longer than 72 bytes.
+func (s *Store) Create(ctx context.Context, username, password, role string, now time.Time) (*User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	user := &User{
+		ID:           primitive.NewObjectID(),
+		Username:     normalize(username),
+		PasswordHash: hash,
+		Role:         role,
+		CreatedAt:    now,
+	}
+	if _, err := s.users.InsertOne(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Authenticate returns the user with the given username and password.
+func (s *Store) Authenticate(ctx context.Context, username, password string) (*User, error) {
+	var user User
+	err := s.users.FindOne(ctx, bson.M{"username": normalize(username)}).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	if bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password)) != nil {
+		return nil, ErrInvalidCredentials
+	}
+	return &user, nil
+}
+
+// Get returns the user with the given id.
+func (s *Store) Get(ctx context.Context, id primitive.ObjectID) (*User, error) {
+	var user User
+	if err := s.users.FindOne(ctx, bson.M{"_id": id}).Decode(&user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// Find returns the user named by ref, which may be a username or the hex
+// string of a user id. It returns mongo.ErrNoDocuments if there is no such
+// user.
+func (s *Store) Find(ctx context.Context, ref string) (*User, error) {
+	filter := bson.M{"username": normalize(ref)}
+	if id, err := primitive.ObjectIDFromHex(ref); err == nil {
+		filter = bson.M{"$or": bson.A{filter, bson.M{"_id": id}}}
+	}
+	var user User
+	if err := s.users.FindOne(ctx, filter).Decode(&user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// Roles returns the roles of the user whose id is the hex string subject,
+// as access tokens name it. It returns mongo.ErrNoDocuments if there is no
+// such user.
+func (s *Store) Roles(ctx context.Context, subject string) ([]string, error) {
+	id, err := primitive.ObjectIDFromHex(subject)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	user, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return []string{user.Role}, nil
+}
+
+// SetRole changes the role of the user with the given username. It returns
+// mongo.ErrNoDocuments if there is no such user.
+func (s *Store) SetRole(ctx context.Context, username, role string) error {
+	res, err := s.users.UpdateOne(ctx, bson.M{"username": normalize(username)}, bson.M{"$set": bson.M{"role": role}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// IssueRefreshToken returns a new refresh token for userID valid until
+// expires.
+func (s *Store) IssueRefreshToken(ctx context.Context, userID primitive.ObjectID, expires time.Time) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	_, err := s.tokens.InsertOne(ctx, refreshToken{Hash: hashToken(token), UserID: userID, ExpiresAt: expires})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// ConsumeRefreshToken invalidates token and returns the user it was issued
+// to. Each token can be consumed once.
+func (s *Store) ConsumeRefreshToken(ctx context.Context, token string, now time.Time) (primitive.ObjectID, error) {
+	var stored refreshToken
+	// Expired tokens are removed by a TTL index, but only periodically.
+	filter := bson.M{"_id": hashToken(token), "expires_at": bson.M{"$gt": now}}
+	err := s.tokens.FindOneAndDelete(ctx, filter).Decode(&stored)
+	if err == mongo.ErrNoDocuments {
+		return primitive.NilObjectID, ErrInvalidCredentials
+	}
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return stored.UserID, nil
+}
+
+// RevokeRefreshToken invalidates token. Unknown tokens are ignored.
+func (s *Store) RevokeRefreshToken(ctx context.Context, token string) error {
+	_, err := s.tokens.DeleteOne(ctx, bson.M{"_id": hashToken(token)})
+	return err
+}
+
+func normalize(username string) string {
+	return strings.ToLower(username)
+}
+
+func hashToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
@@ -0,0 +1,163 @@
+// Package validate enforces the (assets.rules) field options declared in the
+// proto definitions on incoming requests.
+package validate
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var patterns sync.Map // pattern string -> *regexp.Regexp
+
+// Message checks m against the rules of its fields and returns every
+// violation found. Field paths are relative to m.
+func Message(m proto.Message) []grpcerr.FieldViolation {
+	var violations []grpcerr.FieldViolation
+	validateMessage(m.ProtoReflect(), "", &violations)
+	return violations
+}
+
+// Request validates m and returns an InvalidArgument error listing all
+// violations, or nil if m is valid. prefix is prepended to every field path,
+// which lets batch handlers report the item a violation belongs to.
+func Request(m proto.Message, prefix string) error {
+	violations := Message(m)
+	if len(violations) == 0 {
+		return nil
+	}
+	if prefix != "" {
+		for i := range violations {
+			violations[i].Field = prefix + "." + violations[i].Field
+		}
+	}
+	return grpcerr.BadRequest(violations...)
+}
+
+// UnaryServerInterceptor rejects requests that violate their field rules
+// before the handler is called.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if m, ok := req.(proto.Message); ok {
+			if err := Request(m, ""); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func validateMessage(m protoreflect.Message, prefix string, violations *[]grpcerr.FieldViolation) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		rules, _ := proto.GetExtension(fd.Options(), asset.E_Rules).(*asset.FieldRules)
+		if rules == nil {
+			continue
+		}
+		path := prefix + fd.TextName()
+		add := func(format string, args ...interface{}) {
+			*violations = append(*violations, grpcerr.FieldViolation{
+				Field:       path,
+				Description: fmt.Sprintf(format, args...),
+			})
+		}
+		v := m.Get(fd)
+
+		if fd.IsList() {
+			list := v.List()
+			checkLen(list.Len(), "elements", rules, add)
+			if rules.Dive && fd.Kind() == protoreflect.MessageKind {
+				for j := 0; j < list.Len(); j++ {
+					validateMessage(list.Get(j).Message(), fmt.Sprintf("%s[%d].", path, j), violations)
+				}
+			}
+			continue
+		}
+
+		switch fd.Kind() {
+		case protoreflect.StringKind:
+			checkString(v.String(), rules, add)
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+			protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			checkNumber(float64(v.Int()), rules, add)
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			checkNumber(float64(v.Uint()), rules, add)
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			checkNumber(v.Float(), rules, add)
+		case protoreflect.MessageKind:
+			if rules.Dive && m.Has(fd) {
+				validateMessage(v.Message(), path+".", violations)
+			}
+		}
+	}
+}
+
+func checkString(s string, rules *asset.FieldRules, add func(string, ...interface{})) {
+	if rules.Required && strings.TrimSpace(s) == "" {
+		add("must not be empty")
+		return
+	}
+	checkLen(utf8.RuneCountInString(s), "characters", rules, add)
+	if rules.Pattern != "" && s != "" {
+		re, err := compile(rules.Pattern)
+		if err != nil {
+			add("has an invalid validation pattern")
+		} else if !re.MatchString(s) {
+			add("must match %s", rules.Pattern)
+		}
+	}
+	if rules.ObjectId && !primitive.IsValidObjectID(s) {
+		add("%q is not a valid id", s)
+	}
+}
+
+func checkLen(n int, unit string, rules *asset.FieldRules, add func(string, ...interface{})) {
+	if rules.MinLen > 0 && n < int(rules.MinLen) {
+		add("must have at least %d %s", rules.MinLen, unit)
+	}
+	if rules.MaxLen > 0 && n > int(rules.MaxLen) {
+		add("must have at most %d %s", rules.MaxLen, unit)
+	}
+}
+
+func checkNumber(f float64, rules *asset.FieldRules, add func(string, ...interface{})) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		if rules.Finite {
+			add("must be a finite number")
+		}
+		return
+	}
+	if rules.Gte != nil && f < *rules.Gte {
+		add("must be greater than or equal to %v", *rules.Gte)
+	}
+	if rules.Gt != nil && f <= *rules.Gt {
+		add("must be greater than %v", *rules.Gt)
+	}
+	if rules.Lte != nil && f > *rules.Lte {
+		add("must be less than or equal to %v", *rules.Lte)
+	}
+}
+
+func compile(pattern string) (*regexp.Regexp, error) {
+	if re, ok := patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patterns.Store(pattern, re)
+	return re, nil
+}
@@ -0,0 +1,114 @@
+package validate
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/asset"
+	"github.com/jonathan-dotcom/asset-portfolio-management/server/grpcerr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const validID = "65a1b2c3d4e5f60718293a4b"
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  proto.Message
+		want []grpcerr.FieldViolation
+	}{
+		{
+			name: "valid create",
+			msg:  &asset.CreateAssetRequest{Symbol: "BTC-USD", Quantity: 3, Price: 42.5},
+		},
+		{
+			name: "empty symbol",
+			msg:  &asset.CreateAssetRequest{Symbol: "  "},
+			want: []grpcerr.FieldViolation{{Field: "symbol", Description: "must not be empty"}},
+		},
+		{
+			name: "symbol too long",
+			msg:  &asset.CreateAssetRequest{Symbol: strings.Repeat("A", 33)},
+			want: []grpcerr.FieldViolation{{Field: "symbol", Description: "must have at most 32 characters"}},
+		},
+		{
+			name: "symbol pattern",
+			msg:  &asset.CreateAssetRequest{Symbol: "BTC USD"},
+			want: []grpcerr.FieldViolation{{Field: "symbol", Description: "must match ^[A-Za-z0-9._-]+$"}},
+		},
+		{
+			name: "negative quantity and price",
+			msg:  &asset.CreateAssetRequest{Symbol: "ETH", Quantity: -1, Price: -0.5},
+			want: []grpcerr.FieldViolation{
+				{Field: "quantity", Description: "must be greater than or equal to 0"},
+				{Field: "price", Description: "must be greater than or equal to 0"},
+			},
+		},
+		{
+			name: "infinite price",
+			msg:  &asset.CreateAssetRequest{Symbol: "ETH", Price: math.Inf(1)},
+			want: []grpcerr.FieldViolation{{Field: "price", Description: "must be a finite number"}},
+		},
+		{
+			name: "NaN price",
+			msg:  &asset.CreateAssetRequest{Symbol: "ETH", Price: math.NaN()},
+			want: []grpcerr.FieldViolation{{Field: "price", Description: "must be a finite number"}},
+		},
+		{
+			name: "invalid id",
+			msg:  &asset.DeleteAssetRequest{Id: "42"},
+			want: []grpcerr.FieldViolation{{Field: "id", Description: `"42" is not a valid id`}},
+		},
+		{
+			name: "valid delete",
+			msg:  &asset.DeleteAssetRequest{Id: validID, Version: 2},
+		},
+		{
+			name: "order_by pattern",
+			msg:  &asset.ListAssetsRequest{OrderBy: "price"},
+			want: []grpcerr.FieldViolation{{Field: "order_by", Description: "must match ^(symbol|create_time|update_time)( desc)?$"}},
+		},
+		{
+			name: "descending order_by",
+			msg:  &asset.ListAssetsRequest{OrderBy: "update_time desc"},
+		},
+		{
+			name: "page size above maximum",
+			msg:  &asset.ListAuditEventsRequest{PageSize: 1001},
+			want: []grpcerr.FieldViolation{{Field: "page_size", Description: "must be less than or equal to 1000"}},
+		},
+		{
+			name: "batch too large",
+			msg:  &asset.BatchDeleteAssetsRequest{Requests: make([]*asset.DeleteAssetRequest, 1001)},
+			want: []grpcerr.FieldViolation{{Field: "requests", Description: "must have at most 1000 elements"}},
+		},
+		{
+			name: "batch items are left to the handlers",
+			msg:  &asset.BatchDeleteAssetsRequest{Requests: []*asset.DeleteAssetRequest{{Id: "42"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Message(tt.msg); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Message() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestPrefix(t *testing.T) {
+	err := Request(&asset.DeleteAssetRequest{Id: "42"}, "requests[3]")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Request() = %v, want InvalidArgument", err)
+	}
+	if want := `invalid requests[3].id: "42" is not a valid id`; status.Convert(err).Message() != want {
+		t.Errorf("message = %q, want %q", status.Convert(err).Message(), want)
+	}
+	if err := Request(&asset.DeleteAssetRequest{Id: validID}, "requests[3]"); err != nil {
+		t.Errorf("Request() of a valid message = %v, want nil", err)
+	}
+}